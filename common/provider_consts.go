@@ -6,6 +6,8 @@ const (
 	DefaultStorageEndpoint = "storage.yandexcloud.net"
 	DefaultYMQEndpoint     = "message-queue.api.cloud.yandex.net"
 	DefaultRegion          = "ru-central1"
+
+	DefaultOIDCTokenExchangeEndpoint = "https://auth.yandex.cloud/oauth/token"
 )
 
 var Descriptions = map[string]string{
@@ -31,6 +33,31 @@ var Descriptions = map[string]string{
 		"~> Only one of `token` or `service_account_key_file` must be specified.\n\n" +
 		"~> One can authenticate via instance service account from inside a compute instance. In order to use this method, omit both `token`/`service_account_key_file` and attach service account to the instance. [Working with Yandex Cloud from inside an instance](https://yandex.cloud/docs/compute/operations/vm-connect/auth-inside-vm).\n\n",
 
+	"token_command": "Command, which prints IAM token, e.g. `yc iam create-token`. The command is run again every time the token expires, so long-running operations are not interrupted. " +
+		"The command may print either a bare IAM token, which is refreshed every hour, or JSON output of `yc iam create-token --format json`, which is refreshed before `expires_at`.\n" +
+		"This can also be specified using environment variable `YC_TOKEN_COMMAND`.",
+
+	"token_file": "Path to a file containing IAM token. The file is re-read every time the token expires, so it may be rotated in place by an external process. " +
+		"The file may contain either a bare IAM token, which is re-read every hour, or JSON output of `yc iam create-token --format json`, which is re-read before `expires_at`.\n" +
+		"This can also be specified using environment variable `YC_TOKEN_FILE`.",
+
+	"oidc_token": "OIDC token (JWT) issued by an external identity provider, e.g. GitHub Actions or GitLab CI. The token is exchanged for an IAM token of the service account specified in `oidc_service_account_id` via [Workload Identity Federation](https://yandex.cloud/docs/iam/concepts/workload-identity).\n" +
+		"This can also be specified using environment variable `YC_OIDC_TOKEN`.",
+
+	"oidc_token_file": "Path to a file containing OIDC token (JWT) issued by an external identity provider, e.g. Kubernetes projected service account token. The file is read on every token exchange, so the token may be rotated in place.\n" +
+		"This can also be specified using environment variable `YC_OIDC_TOKEN_FILE`.\n\n" +
+		"~> Only one of `token`, `service_account_key_file`, `token_command`, `token_file`, `oidc_token` or `oidc_token_file` must be specified.",
+
+	"oidc_token_exchange_endpoint": "The endpoint, which OIDC token is exchanged at for IAM token, default value is **" + DefaultOIDCTokenExchangeEndpoint + "**.\n" +
+		"This can also be specified using environment variable `YC_OIDC_TOKEN_EXCHANGE_ENDPOINT`.",
+
+	"oidc_service_account_id": "The ID of the service account, which IAM token is issued on OIDC token exchange. The service account must be linked to the workload identity federation of the token issuer.\n" +
+		"This can also be specified using environment variable `YC_OIDC_SERVICE_ACCOUNT_ID`.",
+
+	"impersonate_service_account_id": "The ID of the service account to impersonate. If specified, all API calls are made on behalf of this service account using IAM token, " +
+		"which is issued with the credentials resolved from other provider attributes. The authenticated subject must have the `iam.serviceAccounts.tokenCreator` role for the service account.\n" +
+		"This can also be specified using environment variable `YC_IMPERSONATE_SERVICE_ACCOUNT_ID`.",
+
 	"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`.",
 
 	"plaintext": "Disable use of TLS. Default value is `false`.",
@@ -53,10 +80,15 @@ var Descriptions = map[string]string{
 	"ymq_secret_key": "Yandex Cloud Message Queue service secret key, which is used when a YMQ queue resource doesn't have a secret key explicitly specified.\n" +
 		"This can also be specified using environment variable `YC_MESSAGE_QUEUE_SECRET_KEY`.",
 
-	"shared_credentials_file": "Shared credentials file path.\nSupported keys: `storage_access_key` and `storage_secret_key`.\n\n" +
+	"shared_credentials_file": "Shared credentials file path.\nSupported keys: `storage_access_key` and `storage_secret_key`.\n" +
+		"The path to yc CLI configuration file (`config.yaml`) is also supported, then `token`, `service-account-key`, `federation-id`, `cloud-id`, `folder-id`, `compute-default-zone` and `endpoint` of the profile are used.\n\n" +
 		"~> The `storage_access_key` and `storage_secret_key` attributes from the shared credentials file are used only when the provider and a storage data/resource do not have an access/secret keys explicitly specified.\n",
 
-	"profile": "Profile name to use in the shared credentials file. Default value is `default`.",
+	"profile": "Profile name to use in the shared credentials file. Default value is `default`, which falls back to the current profile of yc CLI configuration file.\n" +
+		"If `shared_credentials_file` is not specified, the profile is read from yc CLI configuration file `~/.config/yandex-cloud/config.yaml`.",
+
+	"default_labels": "Labels, which are added to every resource, that has `labels` attribute. Labels specified in a resource take precedence over the default ones with the same keys. " +
+		"Default labels are not shown in the plan of the resource as drift, changes of `default_labels` are shown in the plan and are applied to every resource, that has labels.",
 
 	"organization_id": "The ID of the [Cloud Organization](https://yandex.cloud/docs/organization/quickstart) to operate under.",
 }
@@ -6,6 +6,7 @@ var ResourceDescriptions = map[string]string{
 	"name":                "The resource name.",
 	"description":         "The resource description.",
 	"labels":              "A set of key/value label pairs which assigned to resource.",
+	"effective_labels":    "All labels of the resource, including the ones added from provider `default_labels`.",
 	"created_at":          "The creation timestamp of the resource.",
 	"cloud_id":            "The `Cloud ID` which resource belongs to. If it is not provided, the default provider `cloud-id` is used.",
 	"zone":                "The [availability zone](https://cloud.yandex.com/docs/overview/concepts/geo-scope) where resource is located. If it is not provided, the default provider zone will be used.",
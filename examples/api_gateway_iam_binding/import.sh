@@ -0,0 +1,2 @@
+# terraform import yandex_api_gateway_iam_binding.<resource Name> "<api_gateway_id>,<resource Role>"
+terraform import yandex_api_gateway_iam_binding.viewer "d5d**********3kfp4,api-gateway.viewer"
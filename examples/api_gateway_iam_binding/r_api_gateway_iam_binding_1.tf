@@ -0,0 +1,17 @@
+//
+// Create a new API Gateway and new IAM Binding for it.
+//
+resource "yandex_api_gateway" "gateway1" {
+  name = "gateway-name"
+  spec = file("api-gateway-spec.yaml")
+}
+
+resource "yandex_api_gateway_iam_binding" "viewer" {
+  api_gateway_id = yandex_api_gateway.gateway1.id
+
+  role = "api-gateway.viewer"
+
+  members = [
+    "userAccount:some_user_id",
+  ]
+}
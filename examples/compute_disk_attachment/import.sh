@@ -0,0 +1,2 @@
+# terraform import yandex_compute_disk_attachment.<resource Name> <instance_id>/<disk_id>
+terraform import yandex_compute_disk_attachment.my_attachment fhmur**********j51ah/fhmrm**********90r5f
@@ -0,0 +1,41 @@
+//
+// Attach an existing disk to a VM instance.
+//
+resource "yandex_compute_disk" "data" {
+  name = "data-disk"
+  size = 20
+  type = "network-ssd"
+  zone = "ru-central1-a"
+}
+
+resource "yandex_compute_disk_attachment" "data" {
+  instance_id = yandex_compute_instance.default.id
+  disk_id     = yandex_compute_disk.data.id
+  device_name = "data"
+  mode        = "READ_WRITE"
+}
+
+resource "yandex_compute_instance" "default" {
+  name        = "test"
+  platform_id = "standard-v3"
+  zone        = "ru-central1-a"
+
+  resources {
+    cores  = 2
+    memory = 4
+  }
+
+  boot_disk {
+    initialize_params {
+      image_id = "image_id"
+    }
+  }
+
+  network_interface {
+    subnet_id = "subnet_id"
+  }
+
+  lifecycle {
+    ignore_changes = [secondary_disk]
+  }
+}
@@ -0,0 +1,15 @@
+//
+// Snapshot all disks labelled with "backup=true".
+//
+data "yandex_compute_disks" "backup" {
+  labels = {
+    backup = "true"
+  }
+}
+
+resource "yandex_compute_snapshot" "backup" {
+  for_each = toset(data.yandex_compute_disks.backup.ids)
+
+  name           = "backup-${each.key}"
+  source_disk_id = each.key
+}
@@ -0,0 +1,11 @@
+//
+// List all public Ubuntu 22.04 images.
+//
+data "yandex_compute_images" "ubuntu" {
+  folder_id = "standard-images"
+  filter    = "family = \"ubuntu-2204-lts\""
+}
+
+output "ubuntu_image_ids" {
+  value = data.yandex_compute_images.ubuntu.ids
+}
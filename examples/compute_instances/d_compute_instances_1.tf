@@ -0,0 +1,12 @@
+//
+// Get private addresses of all instances of the "web" role.
+//
+data "yandex_compute_instances" "web" {
+  labels = {
+    role = "web"
+  }
+}
+
+output "web_addresses" {
+  value = [for i in data.yandex_compute_instances.web.instances : i.network_interface[0].ip_address]
+}
@@ -0,0 +1,14 @@
+//
+// List production snapshots of the database disk.
+//
+data "yandex_compute_snapshots" "db" {
+  filter = "name = \"db-daily\""
+
+  labels = {
+    env = "prod"
+  }
+}
+
+output "db_snapshot_ids" {
+  value = data.yandex_compute_snapshots.db.ids
+}
@@ -0,0 +1,28 @@
+//
+// Manage all record sets of a DNS zone.
+//
+resource "yandex_dns_zone" "zone1" {
+  name = "my-public-zone"
+  zone = "example.com."
+
+  public = true
+}
+
+resource "yandex_dns_zone_records" "zone1" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  records = {
+    "@/A" = {
+      ttl  = 300
+      data = ["192.0.2.1"]
+    }
+    "www/CNAME" = {
+      ttl  = 300
+      data = ["example.com."]
+    }
+    "@/MX" = {
+      ttl  = 3600
+      data = ["10 mx1.example.com.", "20 mx2.example.com."]
+    }
+  }
+}
@@ -0,0 +1,7 @@
+//
+// Take record sets of a DNS zone from a zone file in BIND format.
+//
+resource "yandex_dns_zone_records" "zone1" {
+  zone_id   = yandex_dns_zone.zone1.id
+  zone_file = file("${path.module}/example.com.zone")
+}
@@ -0,0 +1,16 @@
+//
+// Create a new Yandex Cloud Function from a local source directory.
+// The directory is packaged on every plan and user_hash is computed
+// from the packaged files, so a new version is created only when they change.
+//
+resource "yandex_function" "from-source" {
+  name       = "some_name"
+  runtime    = "python312"
+  entrypoint = "main.handler"
+  memory     = "128"
+  content {
+    source_dir    = "${path.module}/src"
+    excludes      = ["__pycache__", "*.pyc", "tests"]
+    upload_bucket = "functions-packages"
+  }
+}
@@ -0,0 +1,16 @@
+resource "yandex_storage_bucket" "logs" {
+  bucket = "my-logs-bucket"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { CanonicalUser = "service_account_id_here" }
+      Action    = ["s3:GetObject"]
+      Resource = [
+        provider::yandex::bucket_arn("my-logs-bucket"),
+        "${provider::yandex::bucket_arn("my-logs-bucket")}/*",
+      ]
+    }]
+  })
+}
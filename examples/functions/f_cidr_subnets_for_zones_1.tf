@@ -0,0 +1,17 @@
+locals {
+  // { "ru-central1-a" = "10.0.0.0/24", "ru-central1-b" = "10.0.1.0/24", "ru-central1-d" = "10.0.2.0/24" }
+  subnets = provider::yandex::cidr_subnets_for_zones("10.0.0.0/16", 8, ["ru-central1-a", "ru-central1-b", "ru-central1-d"])
+}
+
+resource "yandex_vpc_network" "default" {
+  name = "network"
+}
+
+resource "yandex_vpc_subnet" "default" {
+  for_each = local.subnets
+
+  name           = "subnet-${each.key}"
+  zone           = each.key
+  network_id     = yandex_vpc_network.default.id
+  v4_cidr_blocks = [each.value]
+}
@@ -0,0 +1,14 @@
+locals {
+  member = provider::yandex::parse_iam_member("serviceAccount:aje1234567890")
+}
+
+// Grants the role to the same service account in another folder.
+resource "yandex_resourcemanager_folder_iam_member" "viewer" {
+  folder_id = "folder_id_here"
+  role      = "viewer"
+  member    = "${local.member.type}:${local.member.id}"
+}
+
+output "service_account_id" {
+  value = local.member.id
+}
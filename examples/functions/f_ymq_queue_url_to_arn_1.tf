@@ -0,0 +1,7 @@
+resource "yandex_message_queue" "events" {
+  name = "events"
+}
+
+output "queue_arn" {
+  value = provider::yandex::ymq_queue_url_to_arn(yandex_message_queue.events.id)
+}
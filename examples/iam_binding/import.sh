@@ -0,0 +1,2 @@
+# terraform import yandex_iam_binding.<resource Name> "<resource Type>,<resource Id>,<resource Role>"
+terraform import yandex_iam_binding.reader "logging.group,e23**********qkhb1,logging.reader"
@@ -0,0 +1,17 @@
+//
+// Create a new Logging Group and new IAM Binding for it.
+//
+resource "yandex_logging_group" "group1" {
+  name = "group-name"
+}
+
+resource "yandex_iam_binding" "reader" {
+  resource_type = "logging.group"
+  resource_id   = yandex_logging_group.group1.id
+
+  role = "logging.reader"
+
+  members = [
+    "serviceAccount:some_service_account_id",
+  ]
+}
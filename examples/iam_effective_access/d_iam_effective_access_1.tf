@@ -0,0 +1,28 @@
+//
+// Report access bindings of a folder, which differ from the expected ones.
+//
+data "yandex_iam_effective_access" "folder" {
+  folder_id = "some_folder_id"
+
+  expected_bindings = [
+    {
+      role    = "editor"
+      members = ["serviceAccount:some_service_account_id"]
+    },
+    {
+      role    = "viewer"
+      members = ["group:some_group_id", "userAccount:some_user_id"]
+    },
+  ]
+}
+
+output "unexpected_bindings" {
+  value = [
+    for b in data.yandex_iam_effective_access.folder.unexpected_bindings :
+    "${b.role} ${b.member} (${b.resource_type} ${b.resource_id})"
+  ]
+}
+
+output "missing_bindings" {
+  value = data.yandex_iam_effective_access.folder.missing_bindings
+}
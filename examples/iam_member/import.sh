@@ -0,0 +1,2 @@
+# terraform import yandex_iam_member.<resource Name> "<resource Type>,<resource Id>,<resource Role>,<member Id>"
+terraform import yandex_iam_member.invoker "serverless.function,d4e**********6p0r6,functions.functionInvoker,serviceAccount:aje5a**********qspd3"
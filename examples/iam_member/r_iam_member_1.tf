@@ -0,0 +1,10 @@
+//
+// Create a new Serverless Function and grant a single member access to invoke it.
+//
+resource "yandex_iam_member" "invoker" {
+  resource_type = "serverless.function"
+  resource_id   = yandex_function.test-function.id
+
+  role   = "functions.functionInvoker"
+  member = "serviceAccount:some_service_account_id"
+}
@@ -0,0 +1,11 @@
+//
+// Issue a short-lived IAM token for the provider credentials
+// and pass it to the Kubernetes provider.
+//
+ephemeral "yandex_iam_token" "this" {}
+
+provider "kubernetes" {
+  host                   = yandex_kubernetes_cluster.my_cluster.master[0].external_v4_endpoint
+  cluster_ca_certificate = yandex_kubernetes_cluster.my_cluster.master[0].cluster_ca_certificate
+  token                  = ephemeral.yandex_iam_token.this.iam_token
+}
@@ -0,0 +1,6 @@
+//
+// Issue a short-lived IAM token for the given Service Account key.
+//
+ephemeral "yandex_iam_token" "deployer" {
+  service_account_key_file = "/path/to/deployer-key.json"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_iot_core_device.<resource Name> <resource Id>
+terraform import yandex_iot_core_device.my_device aretq**********uo8va
@@ -0,0 +1,2 @@
+# terraform import yandex_iot_core_registry.<resource Name> <resource Id>
+terraform import yandex_iot_core_registry.my_registry are1s**********3qb1m
@@ -0,0 +1,11 @@
+//
+// Write the kubeconfig of a cluster to a file.
+//
+data "yandex_kubernetes_cluster_kubeconfig" "my_cluster" {
+  cluster_name = "my-cluster"
+}
+
+resource "local_sensitive_file" "kubeconfig" {
+  filename = "${path.module}/kubeconfig.yaml"
+  content  = data.yandex_kubernetes_cluster_kubeconfig.my_cluster.kubeconfig
+}
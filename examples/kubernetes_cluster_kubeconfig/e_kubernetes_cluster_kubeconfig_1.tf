@@ -0,0 +1,20 @@
+//
+// Configure the Kubernetes and Helm providers for a cluster.
+//
+ephemeral "yandex_kubernetes_cluster_kubeconfig" "this" {
+  cluster_id = yandex_kubernetes_cluster.my_cluster.id
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.yandex_kubernetes_cluster_kubeconfig.this.host
+  cluster_ca_certificate = ephemeral.yandex_kubernetes_cluster_kubeconfig.this.cluster_ca_certificate
+  token                  = ephemeral.yandex_kubernetes_cluster_kubeconfig.this.token
+}
+
+provider "helm" {
+  kubernetes {
+    host                   = ephemeral.yandex_kubernetes_cluster_kubeconfig.this.host
+    cluster_ca_certificate = ephemeral.yandex_kubernetes_cluster_kubeconfig.this.cluster_ca_certificate
+    token                  = ephemeral.yandex_kubernetes_cluster_kubeconfig.this.token
+  }
+}
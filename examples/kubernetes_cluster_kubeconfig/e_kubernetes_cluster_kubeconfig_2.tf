@@ -0,0 +1,7 @@
+//
+// Connect to the internal endpoint of a cluster from a cloud network.
+//
+ephemeral "yandex_kubernetes_cluster_kubeconfig" "internal" {
+  cluster_name  = "my-cluster"
+  endpoint_type = "internal"
+}
@@ -0,0 +1,12 @@
+//
+// Read Lockbox Secret Version payload without persisting it into the state.
+//
+ephemeral "yandex_lockbox_secret_version" "my_secret_version" {
+  secret_id  = "some-secret-id"
+  version_id = "some-version-id" # if you don't indicate it, by default refers to the latest version
+}
+
+provider "postgresql" {
+  # ...
+  password = ephemeral.yandex_lockbox_secret_version.my_secret_version.entries[0].text_value
+}
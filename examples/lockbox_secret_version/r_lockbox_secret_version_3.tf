@@ -0,0 +1,19 @@
+//
+// Create a new Lockbox Secret Version with a write-only value, which is never stored in the state.
+//
+resource "yandex_lockbox_secret" "my_secret" {
+  name = "test secret"
+}
+
+ephemeral "random_password" "db_password" {
+  length = 16
+}
+
+resource "yandex_lockbox_secret_version" "my_version" {
+  secret_id = yandex_lockbox_secret.my_secret.id
+  entries {
+    key                   = "db_password"
+    text_value_wo         = ephemeral.random_password.db_password.result
+    text_value_wo_version = 1 # increment to push a new value
+  }
+}
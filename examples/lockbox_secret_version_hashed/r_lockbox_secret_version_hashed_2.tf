@@ -0,0 +1,13 @@
+//
+// Create a new Lockbox Secret Version with a write-only value, which is never stored in the state.
+//
+resource "yandex_lockbox_secret" "my_secret" {
+  name = "test secret"
+}
+
+resource "yandex_lockbox_secret_version_hashed" "my_version" {
+  secret_id               = yandex_lockbox_secret.my_secret.id
+  key_1                   = "db_password"
+  text_value_wo_1         = var.db_password
+  text_value_wo_version_1 = 1 # increment to push a new value
+}
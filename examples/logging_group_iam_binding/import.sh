@@ -0,0 +1,2 @@
+# terraform import yandex_logging_group_iam_binding.<resource Name> "<log_group_id>,<resource Role>"
+terraform import yandex_logging_group_iam_binding.viewer "e23**********qkhb1,logging.reader"
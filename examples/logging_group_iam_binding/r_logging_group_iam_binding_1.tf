@@ -0,0 +1,16 @@
+//
+// Create a new Logging Group and new IAM Binding for it.
+//
+resource "yandex_logging_group" "group1" {
+  name = "group-name"
+}
+
+resource "yandex_logging_group_iam_binding" "viewer" {
+  log_group_id = yandex_logging_group.group1.id
+
+  role = "logging.reader"
+
+  members = [
+    "userAccount:some_user_id",
+  ]
+}
@@ -0,0 +1,10 @@
+//
+// Configure the Yandex Cloud Provider (Service Account Impersonation)
+//
+provider "yandex" {
+  token                          = "auth_token_here"
+  impersonate_service_account_id = "service_account_id_here"
+  cloud_id                       = "cloud_id_here"
+  folder_id                      = "folder_id_here"
+  zone                           = "ru-central1-d"
+}
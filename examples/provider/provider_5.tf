@@ -0,0 +1,9 @@
+//
+// Configure the Yandex Cloud Provider (Refreshable IAM Token)
+//
+provider "yandex" {
+  token_command = "yc iam create-token --format json"
+  cloud_id      = "cloud_id_here"
+  folder_id     = "folder_id_here"
+  zone          = "ru-central1-d"
+}
@@ -0,0 +1,19 @@
+//
+// Configure the Yandex Cloud Provider (Default Labels)
+//
+provider "yandex" {
+  default_labels = {
+    team        = "platform"
+    env         = "prod"
+    cost-center = "cc-1234"
+  }
+}
+
+resource "yandex_vpc_network" "default" {
+  name = "network"
+
+  // Resulting labels: team = "platform", env = "testing", cost-center = "cc-1234".
+  labels = {
+    env = "testing"
+  }
+}
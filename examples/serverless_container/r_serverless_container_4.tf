@@ -0,0 +1,22 @@
+//
+// Build a Serverless Container image from a local Docker context.
+//
+resource "yandex_container_repository" "app" {
+  name = "${yandex_container_registry.registry.id}/app"
+}
+
+resource "yandex_serverless_container" "app" {
+  name               = "app"
+  memory             = 256
+  service_account_id = "are1service2account3id"
+  image {
+    url = "cr.yandex/${yandex_container_repository.app.name}:latest"
+    build {
+      context    = "${path.module}/app"
+      dockerfile = "Dockerfile"
+      build_args = {
+        VERSION = "1.0.0"
+      }
+    }
+  }
+}
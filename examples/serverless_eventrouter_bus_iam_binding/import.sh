@@ -0,0 +1,2 @@
+# terraform import yandex_serverless_eventrouter_bus_iam_binding.<resource Name> "<bus_id>,<resource Role>"
+terraform import yandex_serverless_eventrouter_bus_iam_binding.viewer "f66**********vb3pm,serverless.eventrouter.viewer"
@@ -0,0 +1,16 @@
+//
+// Create a new Event Router Bus and new IAM Binding for it.
+//
+resource "yandex_serverless_eventrouter_bus" "bus1" {
+  name = "bus-name"
+}
+
+resource "yandex_serverless_eventrouter_bus_iam_binding" "viewer" {
+  bus_id = yandex_serverless_eventrouter_bus.bus1.id
+
+  role = "serverless.eventrouter.viewer"
+
+  members = [
+    "userAccount:some_user_id",
+  ]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_storage_object.<resource Name> <bucket>/<key>
+terraform import yandex_storage_object.cute-cat-picture cat-pictures/cute-cat
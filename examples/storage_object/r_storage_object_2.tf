@@ -0,0 +1,15 @@
+//
+// Upload a static website page with caching headers.
+//
+resource "yandex_storage_object" "index" {
+  bucket        = "my-website"
+  key           = "index.html"
+  source        = "site/index.html"
+  source_hash   = filemd5("site/index.html")
+  content_type  = "text/html"
+  cache_control = "public, max-age=300"
+
+  metadata = {
+    build-id = "42"
+  }
+}
@@ -0,0 +1,15 @@
+//
+// Upload a frontend bundle into a static website bucket.
+//
+resource "yandex_storage_objects_sync" "frontend" {
+  bucket     = "my-website"
+  prefix     = "app/"
+  source_dir = "${path.module}/dist"
+  excludes   = ["*.map", ".DS_Store"]
+  acl        = "public-read"
+
+  cache_control = "public, max-age=300"
+  content_types = {
+    ".webmanifest" = "application/manifest+json"
+  }
+}
@@ -0,0 +1,10 @@
+//
+// Get reserved addresses in ru-central1-a.
+//
+data "yandex_vpc_addresses" "zone_a" {
+  zone = "ru-central1-a"
+}
+
+output "addresses" {
+  value = [for a in data.yandex_vpc_addresses.zone_a.addresses : a.external_ipv4_address[0].address]
+}
@@ -0,0 +1,12 @@
+//
+// Get all networks labelled as shared.
+//
+data "yandex_vpc_networks" "shared" {
+  labels = {
+    shared = "true"
+  }
+}
+
+output "shared_subnet_ids" {
+  value = flatten(data.yandex_vpc_networks.shared.networks[*].subnet_ids)
+}
@@ -0,0 +1,10 @@
+//
+// Get all security groups of a network.
+//
+data "yandex_vpc_security_groups" "network" {
+  network_id = "enp**********"
+}
+
+output "security_group_ids" {
+  value = data.yandex_vpc_security_groups.network.ids
+}
@@ -0,0 +1,20 @@
+//
+// Use all subnets of a network in ru-central1-a for an instance group.
+//
+data "yandex_vpc_subnets" "zone_a" {
+  network_id = yandex_vpc_network.shared.id
+  zone       = "ru-central1-a"
+}
+
+resource "yandex_compute_instance_group" "group" {
+  # ...
+
+  instance_template {
+    # ...
+
+    network_interface {
+      network_id = yandex_vpc_network.shared.id
+      subnet_ids = data.yandex_vpc_subnets.zone_a.ids
+    }
+  }
+}
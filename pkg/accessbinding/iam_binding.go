@@ -3,6 +3,7 @@ package accessbinding
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -33,7 +34,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	policies := getResourceIamBindings(ctx, req.Plan, &resp.Diagnostics)
-	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(ep *Policy) error {
+	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, nil, func(ep *Policy) error {
 		// Creating a binding does not remove existing members if they are not in the provided members list.
 		// This prevents removing existing permission without the user's knowledge.
 		// Instead, a diff is shown in that case after creation. Subsequent calls to update will remove any
@@ -51,10 +52,13 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	r.RefreshBindingState(ctx, req.Plan, &resp.State, resp.Diagnostics)
+	r.RefreshBindingState(ctx, req.Plan, &resp.State, resp.Diagnostics, true)
 }
 
-func (r *bindingResource) RefreshBindingState(ctx context.Context, req Extractable, resp Settable, diag diag.Diagnostics) {
+// RefreshBindingState stores members of the role in resp. If onlyKnown is set, members are limited to the ones
+// in req, so the state after apply matches the plan. Otherwise all members are stored, so members added outside
+// of Terraform are shown in the plan and are taken into account by the concurrent modification check.
+func (r *bindingResource) RefreshBindingState(ctx context.Context, req Extractable, resp Settable, diag diag.Diagnostics, onlyKnown bool) {
 	var role types.String
 	diag.Append(req.GetAttribute(ctx, path.Root("role"), &role)...)
 
@@ -76,7 +80,7 @@ func (r *bindingResource) RefreshBindingState(ctx context.Context, req Extractab
 		if b.RoleId != role.ValueString() {
 			continue
 		}
-		if len(eBindings) != 0 {
+		if onlyKnown && len(eBindings) != 0 {
 			for _, e := range eBindings {
 				if canonicalMember(e) != canonicalMember(b) {
 					continue
@@ -102,6 +106,7 @@ func (r *bindingResource) RefreshBindingState(ctx context.Context, req Extractab
 	diag.Append(resp.SetAttribute(ctx, path.Root("members"), mBindingsSet)...)
 	diag.Append(resp.SetAttribute(ctx, path.Root("role"), role)...)
 	diag.Append(resp.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+	copyIdAttributes(ctx, r.ResourceUpdater, req, resp, &diag)
 }
 
 func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -110,17 +115,18 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	r.RefreshBindingState(ctx, req.State, &resp.State, resp.Diagnostics)
+	r.RefreshBindingState(ctx, req.State, &resp.State, resp.Diagnostics, false)
 }
 
 func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
 	bindings := getResourceIamBindings(ctx, req.Plan, &resp.Diagnostics)
+	stateBindings := getResourceIamBindings(ctx, req.State, &resp.Diagnostics)
 
 	var stateRole types.String
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("role"), &stateRole)...)
 
-	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(p *Policy) error {
+	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, stateBindings, func(p *Policy) error {
 		p.Bindings = removeRoleFromBindings(stateRole.ValueString(), p.Bindings)
 		p.Bindings = append(p.Bindings, bindings...)
 		return nil
@@ -133,7 +139,7 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 				"Error: %s", err))
 		return
 	}
-	r.RefreshBindingState(ctx, req.Plan, &resp.State, resp.Diagnostics)
+	r.RefreshBindingState(ctx, req.Plan, &resp.State, resp.Diagnostics, true)
 }
 
 func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -151,7 +157,7 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 	role := binding[0].RoleId
 
-	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(p *Policy) error {
+	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, binding, func(p *Policy) error {
 		p.Bindings = removeRoleFromBindings(role, p.Bindings)
 		return nil
 	})
@@ -164,7 +170,7 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 				"Error: %s", err))
 		return
 	}
-	r.RefreshBindingState(ctx, req.State, &resp.State, resp.Diagnostics)
+	r.RefreshBindingState(ctx, req.State, &resp.State, resp.Diagnostics, true)
 }
 
 func (r *bindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -204,18 +210,7 @@ func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 }
 
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: {resource_id},{role}. Got: %q", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[1])...)
+	importIamState(ctx, r.ResourceUpdater, req.ID, []string{"role"}, resp)
 }
 
 // all bindings use same Role
@@ -236,3 +231,45 @@ func getResourceIamBindings(ctx context.Context, state Extractable, diag *diag.D
 	}
 	return result
 }
+
+// copyIdAttributes copies resource identifying attributes other than the id alias from req to resp.
+func copyIdAttributes(ctx context.Context, updater ResourceIamUpdater, req Extractable, resp Settable, diag *diag.Diagnostics) {
+	for _, attr := range idAttributes(updater) {
+		if attr == updater.GetIdAlias() {
+			continue
+		}
+		var value types.String
+		diag.Append(req.GetAttribute(ctx, path.Root(attr), &value)...)
+		diag.Append(resp.SetAttribute(ctx, path.Root(attr), value)...)
+	}
+}
+
+// importIamState parses import identifier made of the resource identifying attributes
+// followed by the given attributes, separated by commas.
+func importIamState(ctx context.Context, updater ResourceIamUpdater, id string, attrs []string, resp *resource.ImportStateResponse) {
+	attrs = slices.Concat(idAttributes(updater), attrs)
+	idParts := strings.Split(id, ",")
+
+	valid := len(idParts) == len(attrs)
+	for _, part := range idParts {
+		valid = valid && part != ""
+	}
+	if !valid {
+		format := make([]string, len(attrs))
+		for i, attr := range attrs {
+			if attr == updater.GetIdAlias() {
+				attr = "resource_id"
+			}
+			format[i] = "{" + attr + "}"
+		}
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(format, ","), id),
+		)
+		return
+	}
+
+	for i, attr := range attrs {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), idParts[i])...)
+	}
+}
@@ -0,0 +1,233 @@
+package accessbinding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+)
+
+type memberResource struct {
+	ResourceUpdater ResourceIamUpdater
+}
+
+// NewIamMember creates a resource managing a single member of a role within IAM policy.
+// Unlike NewIamBinding, other members of the role are left untouched.
+func NewIamMember(updater ResourceIamUpdater) resource.Resource {
+	return &memberResource{updater}
+}
+
+func (r *memberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+
+	binding := getResourceIamMember(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := iamPolicyReadModifyUpdate(ctx, r.ResourceUpdater, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{
+				Action:        access.AccessBindingAction_ADD,
+				AccessBinding: binding,
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Add Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while attempting to add resource policy member. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+
+	r.refreshMemberState(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+// refreshMemberState copies the member to resp, if it is still bound to the role, and reports
+// whether it was found.
+func (r *memberResource) refreshMemberState(ctx context.Context, req Extractable, resp Settable, diag *diag.Diagnostics) bool {
+	eBinding := getResourceIamMember(ctx, req, diag)
+	if diag.HasError() {
+		return false
+	}
+
+	policy, err := r.ResourceUpdater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted", r.ResourceUpdater.DescribeResource()))
+			return false
+		}
+		diag.AddError(
+			"Unable to Refresh Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while refreshing resource policies. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return false
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Retrieved access bindings of %s: %+v", r.ResourceUpdater.DescribeResource(), policy))
+
+	found := false
+	for _, b := range policy.Bindings {
+		if b.RoleId == eBinding.RoleId && canonicalMember(b) == canonicalMember(eBinding) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("Member %q for role %q does not exist in access bindings of %s",
+			canonicalMember(eBinding), eBinding.RoleId, r.ResourceUpdater.DescribeResource()))
+		return false
+	}
+
+	diag.Append(resp.SetAttribute(ctx, path.Root("role"), eBinding.RoleId)...)
+	diag.Append(resp.SetAttribute(ctx, path.Root("member"), canonicalMember(eBinding))...)
+	diag.Append(resp.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+	copyIdAttributes(ctx, r.ResourceUpdater, req, resp, diag)
+	return true
+}
+
+func (r *memberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.refreshMemberState(ctx, req.State, &resp.State, &resp.Diagnostics) && !resp.Diagnostics.HasError() {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+// Update is never called, since all attributes require replacement.
+func (r *memberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *memberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+
+	binding := getResourceIamMember(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := iamPolicyReadModifyUpdate(ctx, r.ResourceUpdater, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{
+				Action:        access.AccessBindingAction_REMOVE,
+				AccessBinding: binding,
+			},
+		},
+	})
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, marking policy member as deleted",
+				r.ResourceUpdater.DescribeResource()))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Remove Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while removing resource policy member. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+	}
+}
+
+func (r *memberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.ResourceUpdater.GetNameSuffix()
+}
+
+func (r *memberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ResourceUpdater.Configure(ctx, req, resp)
+}
+
+func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Allows creation and management of a single member for a single binding within IAM policy for an existing resource.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role that should be assigned.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member": schema.StringAttribute{
+				MarkdownDescription: "An identity that will be granted the privilege in the `role`. Entry can have one of the following values:\n * **userAccount:{user_id}**: A unique user ID that represents a specific Yandex account.\n * **serviceAccount:{service_account_id}**: A unique service account ID.\n * **federatedUser:{federated_user_id}**: A unique federated user ID.\n * **group:{group_id}**: A unique group ID.\n * **system:group:federation:{federation_id}:users**: All users in federation.\n * **system:group:organization:{organization_id}:users**: All users in organization.\n * **system:allAuthenticatedUsers**: All authenticated users.\n * **system:allUsers**: All users, including unauthenticated ones.\n\n~> for more information about system groups, see [Cloud Documentation](https://yandex.cloud/docs/iam/concepts/access-control/system-group).\n\n",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					memberValidator{},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, r.ResourceUpdater.GetSchemaAttributes())
+}
+
+func (r *memberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importIamState(ctx, r.ResourceUpdater, req.ID, []string{"role", "member"}, resp)
+}
+
+func getResourceIamMember(ctx context.Context, state Extractable, diag *diag.Diagnostics) *access.AccessBinding {
+	var role, member types.String
+
+	diag.Append(state.GetAttribute(ctx, path.Root("role"), &role)...)
+	diag.Append(state.GetAttribute(ctx, path.Root("member"), &member)...)
+	if diag.HasError() {
+		return nil
+	}
+
+	if !isValidMember(member.ValueString()) {
+		diag.AddError("Invalid Member", fmt.Sprintf("Expected member in TYPE:ID format, got %q", member.ValueString()))
+		return nil
+	}
+
+	return roleMemberToAccessBinding(role.ValueString(), member.ValueString())
+}
+
+func isValidMember(member string) bool {
+	_, _, err := ParseMember(member)
+	return err == nil
+}
+
+type memberValidator struct{}
+
+func (v memberValidator) Description(_ context.Context) string {
+	return "value must be in TYPE:ID format"
+}
+
+func (v memberValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v memberValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !isValidMember(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Member",
+			fmt.Sprintf("Expected member in TYPE:ID format, got %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
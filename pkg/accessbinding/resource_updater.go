@@ -2,7 +2,9 @@ package accessbinding
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -61,6 +63,21 @@ type ResourceIamUpdater interface {
 	GetId() string
 }
 
+// ResourceIamIdAttributesUpdater is implemented by updaters, which identify the resource
+// with several schema attributes, e.g. a resource type and a resource ID.
+type ResourceIamIdAttributesUpdater interface {
+	// GetIdAttributes Gets schema attributes identifying the resource in the import identifier order.
+	// It must include the attribute returned by GetIdAlias.
+	GetIdAttributes() []string
+}
+
+func idAttributes(updater ResourceIamUpdater) []string {
+	if u, ok := updater.(ResourceIamIdAttributesUpdater); ok {
+		return u.GetIdAttributes()
+	}
+	return []string{updater.GetIdAlias()}
+}
+
 type Extractable interface {
 	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
 }
@@ -71,7 +88,17 @@ type Settable interface {
 
 type iamPolicyModifyFunc func(p *Policy) error
 
-func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
+// ErrConcurrentModification is returned when access bindings of the roles managed by the resource are
+// changed by someone else between reading and updating them.
+var ErrConcurrentModification = errors.New("access bindings were modified concurrently")
+
+// iamPolicyReadModifySet reads access bindings of the resource, applies the modification and sends the difference
+// as access binding deltas, so bindings of other roles, which are changed concurrently, are never overwritten.
+// prior holds the bindings of the roles managed by the resource as of the last refresh of its state, it is nil
+// on creation. If members of these roles were changed since then, e.g. by another pipeline between plan and apply,
+// the update is refused with ErrConcurrentModification, so members the plan did not show are never removed.
+// Concurrent changes during the update are reported with ErrConcurrentModification too.
+func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, prior []*access.AccessBinding, modify iamPolicyModifyFunc) error {
 	mutexKey := updater.GetMutexKey()
 	mutexKV := globallock.GetMutexKV()
 	mutexKV.Lock(mutexKey)
@@ -86,19 +113,62 @@ func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, mod
 
 	tflog.Debug(ctx, fmt.Sprintf("Retrieved access bindings for %s: %+v\n", updater.DescribeResource(), p))
 
-	err = modify(p)
+	if changed := changedRoles(prior, p.Bindings, bindingRoles(prior)); len(changed) != 0 {
+		return fmt.Errorf("%w: members of roles %s of %s were changed since the last refresh, "+
+			"please refresh the state and review the plan",
+			ErrConcurrentModification, strings.Join(changed, ", "), updater.DescribeResource())
+	}
+
+	modified := &Policy{Bindings: append([]*access.AccessBinding(nil), p.Bindings...)}
+	err = modify(modified)
 	if err != nil {
 		return err
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Setting access bindings for %s to %+v", updater.DescribeResource(), p))
+	deltas := accessBindingDeltas(p.Bindings, modified.Bindings)
+	if len(deltas) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Access bindings for %s are up to date", updater.DescribeResource()))
+		return nil
+	}
+	roles := deltaRoles(deltas)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating access bindings for %s with %+v", updater.DescribeResource(), deltas))
 
-	err = updater.SetResourceIamPolicy(ctx, p)
+	err = updater.UpdateResourceIamPolicy(ctx, &PolicyDelta{Deltas: deltas})
 	if err != nil {
 		return fmt.Errorf("Error applying access bindings to %s: %w", updater.DescribeResource(), err)
 	}
 
+	updated, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if changed := changedRoles(modified.Bindings, updated.Bindings, roles); len(changed) != 0 {
+		return fmt.Errorf("%w: roles %s of %s were changed while they were updated, please review them",
+			ErrConcurrentModification, strings.Join(changed, ", "), updater.DescribeResource())
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Set policy for %s", updater.DescribeResource()))
 
 	return nil
 }
+
+// iamPolicyReadModifyUpdate sends access binding deltas as is. Deltas are applied atomically by the API,
+// so there is no need to read access bindings first.
+func iamPolicyReadModifyUpdate(ctx context.Context, updater ResourceIamUpdater, policyDelta *PolicyDelta) error {
+	mutexKey := updater.GetMutexKey()
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating access bindings of %s with %+v", updater.DescribeResource(), policyDelta))
+
+	err := updater.UpdateResourceIamPolicy(ctx, policyDelta)
+	if err != nil {
+		return fmt.Errorf("Error updating access bindings of %s: %w", updater.DescribeResource(), err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updated access bindings of %s", updater.DescribeResource()))
+
+	return nil
+}
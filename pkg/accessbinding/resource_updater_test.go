@@ -0,0 +1,182 @@
+package accessbinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+// fakeUpdater keeps access bindings in memory. beforeGet is called before every read
+// to simulate changes made concurrently by someone else.
+type fakeUpdater struct {
+	bindings  []*access.AccessBinding
+	gets      int
+	sets      int
+	updates   [][]*access.AccessBindingDelta
+	beforeGet func(u *fakeUpdater)
+}
+
+func (u *fakeUpdater) GetResourceIamPolicy(context.Context) (*Policy, error) {
+	u.gets++
+	if u.beforeGet != nil {
+		u.beforeGet(u)
+	}
+	return &Policy{Bindings: append([]*access.AccessBinding(nil), u.bindings...)}, nil
+}
+
+func (u *fakeUpdater) SetResourceIamPolicy(_ context.Context, policy *Policy) error {
+	u.sets++
+	u.bindings = policy.Bindings
+	return nil
+}
+
+func (u *fakeUpdater) UpdateResourceIamPolicy(_ context.Context, policy *PolicyDelta) error {
+	u.updates = append(u.updates, policy.Deltas)
+	for _, d := range policy.Deltas {
+		switch d.Action {
+		case access.AccessBindingAction_ADD:
+			u.bindings = append(u.bindings, d.AccessBinding)
+		case access.AccessBindingAction_REMOVE:
+			u.bindings = removeMember(u.bindings, d.AccessBinding.RoleId, canonicalMember(d.AccessBinding))
+		}
+	}
+	return nil
+}
+
+func (u *fakeUpdater) GetMutexKey() string                                        { return "iam-fake" }
+func (u *fakeUpdater) Initialize(context.Context, Extractable, *diag.Diagnostics) {}
+func (u *fakeUpdater) DescribeResource() string                                   { return "fake 'id'" }
+func (u *fakeUpdater) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+func (u *fakeUpdater) GetSchemaAttributes() map[string]schema.Attribute { return nil }
+func (u *fakeUpdater) GetNameSuffix() string                            { return "fake_iam_binding" }
+func (u *fakeUpdater) GetIdAlias() string                               { return "fake_id" }
+func (u *fakeUpdater) GetId() string                                    { return "id" }
+
+func removeMember(bindings []*access.AccessBinding, role, member string) []*access.AccessBinding {
+	var out []*access.AccessBinding
+	for _, b := range bindings {
+		if b.RoleId != role || canonicalMember(b) != member {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func deltaStrings(deltas []*access.AccessBindingDelta) []string {
+	var out []string
+	for _, d := range deltas {
+		out = append(out, d.Action.String()+" "+d.AccessBinding.RoleId+" "+canonicalMember(d.AccessBinding))
+	}
+	return out
+}
+
+func TestIamPolicyReadModifySet(t *testing.T) {
+	ctx := context.Background()
+
+	u := &fakeUpdater{bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("editor", "userAccount:b"),
+	}}
+	// Someone grants another role since the last refresh, it must survive the update.
+	u.beforeGet = func(u *fakeUpdater) {
+		if u.gets == 1 {
+			u.bindings = append(u.bindings, roleMemberToAccessBinding("admin", "userAccount:c"))
+		}
+	}
+	prior := []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:a")}
+
+	err := iamPolicyReadModifySet(ctx, u, prior, func(p *Policy) error {
+		p.Bindings = removeRoleFromBindings("viewer", p.Bindings)
+		p.Bindings = append(p.Bindings, roleMemberToAccessBinding("viewer", "userAccount:d"))
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Zero(t, u.sets)
+	assert.Equal(t, 2, u.gets)
+	require.Len(t, u.updates, 1)
+	assert.Equal(t, []string{"ADD viewer userAccount:d", "REMOVE viewer userAccount:a"}, deltaStrings(u.updates[0]))
+	assert.ElementsMatch(t, []string{"viewer", "editor", "admin"}, sortedKeys(rolesToMembersMap(u.bindings)))
+}
+
+func TestIamPolicyReadModifySet_noChanges(t *testing.T) {
+	u := &fakeUpdater{bindings: []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:a")}}
+
+	err := iamPolicyReadModifySet(context.Background(), u, nil, func(p *Policy) error {
+		p.Bindings = mergeBindings(append(p.Bindings, roleMemberToAccessBinding("viewer", "userAccount:a")))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, u.updates)
+	assert.Equal(t, 1, u.gets)
+}
+
+func TestIamPolicyReadModifySet_withoutPrior(t *testing.T) {
+	u := &fakeUpdater{bindings: []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:x")}}
+
+	err := iamPolicyReadModifySet(context.Background(), u, nil, func(p *Policy) error {
+		p.Bindings = mergeBindings(append(p.Bindings, roleMemberToAccessBinding("viewer", "userAccount:a")))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, u.updates, 1)
+	assert.Equal(t, []string{"ADD viewer userAccount:a"}, deltaStrings(u.updates[0]))
+}
+
+func TestIamPolicyReadModifySet_concurrentModification(t *testing.T) {
+	prior := []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:a")}
+
+	t.Run("since last refresh", func(t *testing.T) {
+		// Another pipeline granted the role between refresh and apply.
+		u := &fakeUpdater{bindings: []*access.AccessBinding{
+			roleMemberToAccessBinding("viewer", "userAccount:a"),
+			roleMemberToAccessBinding("viewer", "userAccount:x"),
+		}}
+
+		err := iamPolicyReadModifySet(context.Background(), u, prior, func(p *Policy) error {
+			p.Bindings = removeRoleFromBindings("viewer", p.Bindings)
+			return nil
+		})
+		require.ErrorIs(t, err, ErrConcurrentModification)
+		assert.ErrorContains(t, err, "roles viewer of fake 'id' were changed since the last refresh")
+		assert.Empty(t, u.updates)
+		assert.Equal(t, 1, u.gets)
+	})
+
+	t.Run("during update", func(t *testing.T) {
+		u := &fakeUpdater{bindings: []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:a")}}
+		u.beforeGet = func(u *fakeUpdater) {
+			if u.gets == 2 {
+				u.bindings = append(u.bindings, roleMemberToAccessBinding("viewer", "userAccount:x"))
+			}
+		}
+
+		err := iamPolicyReadModifySet(context.Background(), u, prior, func(p *Policy) error {
+			p.Bindings = removeRoleFromBindings("viewer", p.Bindings)
+			return nil
+		})
+		require.ErrorIs(t, err, ErrConcurrentModification)
+		assert.ErrorContains(t, err, "roles viewer of fake 'id' were changed while they were updated")
+		assert.Zero(t, u.sets)
+	})
+}
+
+func TestIamPolicyReadModifyUpdate(t *testing.T) {
+	u := &fakeUpdater{}
+
+	err := iamPolicyReadModifyUpdate(context.Background(), u, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{Action: access.AccessBindingAction_ADD, AccessBinding: roleMemberToAccessBinding("viewer", "userAccount:a")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, u.gets)
+	assert.Len(t, u.updates, 1)
+}
@@ -2,18 +2,32 @@ package accessbinding
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
 )
 
-func roleMemberToAccessBinding(role, member string) *access.AccessBinding {
+// ParseMember splits IAM member in TYPE:ID format into the subject type and ID.
+// The type is everything before the first colon, so system groups like
+// `system:group:organization:{organization_id}:users` have `system` type.
+func ParseMember(member string) (subjectType, id string, err error) {
 	chunks := strings.SplitN(member, ":", 2)
+	if len(chunks) != 2 || chunks[0] == "" || chunks[1] == "" {
+		return "", "", fmt.Errorf("expected member in TYPE:ID format, got %q", member)
+	}
+	return chunks[0], chunks[1], nil
+}
+
+// roleMemberToAccessBinding expects member to be validated already.
+func roleMemberToAccessBinding(role, member string) *access.AccessBinding {
+	subjectType, id, _ := ParseMember(member)
 	return &access.AccessBinding{
 		RoleId: role,
 		Subject: &access.Subject{
-			Type: chunks[0],
-			Id:   chunks[1],
+			Type: subjectType,
+			Id:   id,
 		},
 	}
 }
@@ -96,3 +110,67 @@ func CountBatches(size, batchSize int) int {
 	}
 	return iterations
 }
+
+// accessBindingDeltas returns deltas, which turn the old bindings into the new ones.
+func accessBindingDeltas(oldBindings, newBindings []*access.AccessBinding) []*access.AccessBindingDelta {
+	oldMap := rolesToMembersMap(oldBindings)
+	newMap := rolesToMembersMap(newBindings)
+
+	var deltas []*access.AccessBindingDelta
+	for _, role := range sortedKeys(newMap) {
+		for _, member := range sortedKeys(newMap[role]) {
+			if !oldMap[role][member] {
+				deltas = append(deltas, &access.AccessBindingDelta{
+					Action:        access.AccessBindingAction_ADD,
+					AccessBinding: roleMemberToAccessBinding(role, member),
+				})
+			}
+		}
+	}
+	for _, role := range sortedKeys(oldMap) {
+		for _, member := range sortedKeys(oldMap[role]) {
+			if !newMap[role][member] {
+				deltas = append(deltas, &access.AccessBindingDelta{
+					Action:        access.AccessBindingAction_REMOVE,
+					AccessBinding: roleMemberToAccessBinding(role, member),
+				})
+			}
+		}
+	}
+	return deltas
+}
+
+func deltaRoles(deltas []*access.AccessBindingDelta) []string {
+	roles := make(map[string]bool)
+	for _, d := range deltas {
+		roles[d.AccessBinding.RoleId] = true
+	}
+	return sortedKeys(roles)
+}
+
+func bindingRoles(bindings []*access.AccessBinding) []string {
+	return sortedKeys(rolesToMembersMap(bindings))
+}
+
+// changedRoles returns the roles, whose members differ in the expected and the actual bindings.
+func changedRoles(expected, actual []*access.AccessBinding, roles []string) []string {
+	expectedMap := rolesToMembersMap(expected)
+	actualMap := rolesToMembersMap(actual)
+
+	var changed []string
+	for _, role := range roles {
+		if !reflect.DeepEqual(sortedKeys(expectedMap[role]), sortedKeys(actualMap[role])) {
+			changed = append(changed, role)
+		}
+	}
+	return changed
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,35 @@
+package accessbinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMember(t *testing.T) {
+	cases := []struct {
+		member      string
+		subjectType string
+		id          string
+	}{
+		{"serviceAccount:aje123", "serviceAccount", "aje123"},
+		{"federatedUser:bfb456:", "federatedUser", "bfb456:"},
+		{"system:group:organization:bpf789:users", "system", "group:organization:bpf789:users"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.member, func(t *testing.T) {
+			subjectType, id, err := ParseMember(tc.member)
+			require.NoError(t, err)
+			assert.Equal(t, tc.subjectType, subjectType)
+			assert.Equal(t, tc.id, id)
+		})
+	}
+
+	for _, member := range []string{"", "userAccount", "userAccount:", ":id"} {
+		t.Run("invalid "+member, func(t *testing.T) {
+			_, _, err := ParseMember(member)
+			assert.ErrorContains(t, err, "expected member in TYPE:ID format")
+		})
+	}
+}
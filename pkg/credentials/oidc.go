@@ -0,0 +1,156 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const (
+	tokenExchangeGrantType          = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenExchangeRequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+	tokenExchangeSubjectTokenType   = "urn:ietf:params:oauth:token-type:id_token"
+
+	tokenExchangeTimeout = 30 * time.Second
+)
+
+// SubjectTokenSource returns an OIDC token (JWT) issued by an external identity provider.
+type SubjectTokenSource func() (string, error)
+
+// StaticSubjectToken returns a source for a token that is passed as is.
+func StaticSubjectToken(token string) SubjectTokenSource {
+	return func() (string, error) {
+		return token, nil
+	}
+}
+
+// FileSubjectToken returns a source for a token that is read from the file on every exchange,
+// since tokens like Kubernetes projected service account tokens are rotated in place.
+func FileSubjectToken(path string) SubjectTokenSource {
+	return func() (string, error) {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read OIDC token file %q: %w", path, err)
+		}
+		token := strings.TrimSpace(string(content))
+		if token == "" {
+			return "", fmt.Errorf("OIDC token file %q is empty", path)
+		}
+		return token, nil
+	}
+}
+
+type tokenExchangeResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type oidcTokenExchangeCredentials struct {
+	endpoint         string
+	serviceAccountID string
+	subjectToken     SubjectTokenSource
+	httpClient       *http.Client
+	now              func() time.Time
+}
+
+// NewOIDCTokenExchange returns credentials, that exchange OIDC token of an external identity provider
+// for IAM token of the service account, using workload identity federation.
+// See https://yandex.cloud/docs/iam/concepts/workload-identity for details.
+func NewOIDCTokenExchange(endpoint, serviceAccountID string, subjectToken SubjectTokenSource) ycsdk.NonExchangeableCredentials {
+	return &oidcTokenExchangeCredentials{
+		endpoint:         endpoint,
+		serviceAccountID: serviceAccountID,
+		subjectToken:     subjectToken,
+		httpClient:       cleanhttp.DefaultClient(),
+		now:              time.Now,
+	}
+}
+
+func (c *oidcTokenExchangeCredentials) YandexCloudAPICredentials() {}
+
+func (c *oidcTokenExchangeCredentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	subjectToken, err := c.subjectToken()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", tokenExchangeGrantType)
+	form.Set("requested_token_type", tokenExchangeRequestedTokenType)
+	form.Set("audience", c.serviceAccountID)
+	form.Set("subject_token", subjectToken)
+	form.Set("subject_token_type", tokenExchangeSubjectTokenType)
+
+	ctx, cancel := context.WithTimeout(ctx, tokenExchangeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OIDC token exchange request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	issuedAt := c.now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange OIDC token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OIDC token exchange response: %w", err)
+	}
+
+	var exchangeResp tokenExchangeResponse
+	if err := json.Unmarshal(body, &exchangeResp); err != nil {
+		return nil, fmt.Errorf("failed to exchange OIDC token: unexpected response (status %d): %s", resp.StatusCode, body)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to exchange OIDC token for service account %q: %s: %s",
+			c.serviceAccountID, exchangeResp.Error, exchangeResp.ErrorDescription)
+	}
+
+	if exchangeResp.AccessToken == "" {
+		return nil, fmt.Errorf("failed to exchange OIDC token for service account %q: response contains no access token", c.serviceAccountID)
+	}
+
+	return &iam.CreateIamTokenResponse{
+		IamToken:  exchangeResp.AccessToken,
+		ExpiresAt: timestamppb.New(issuedAt.Add(time.Duration(exchangeResp.ExpiresIn) * time.Second)),
+	}, nil
+}
+
+// OIDCTokenExchange builds credentials from the provider configuration. OIDC token is taken
+// either from the token value or from the token file, the file takes precedence.
+// The default token exchange endpoint is used, if endpoint is empty.
+func OIDCTokenExchange(token, tokenFile, serviceAccountID, endpoint string) (ycsdk.Credentials, error) {
+	if serviceAccountID == "" {
+		return nil, fmt.Errorf("'oidc_service_account_id' should be specified in order to exchange OIDC token for IAM token")
+	}
+
+	source := StaticSubjectToken(token)
+	if tokenFile != "" {
+		source = FileSubjectToken(tokenFile)
+	}
+	if endpoint == "" {
+		endpoint = common.DefaultOIDCTokenExchangeEndpoint
+	}
+	return NewOIDCTokenExchange(endpoint, serviceAccountID, source), nil
+}
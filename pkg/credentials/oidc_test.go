@@ -0,0 +1,94 @@
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func TestOIDCTokenExchange(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, tokenExchangeGrantType, r.PostForm.Get("grant_type"))
+		assert.Equal(t, tokenExchangeRequestedTokenType, r.PostForm.Get("requested_token_type"))
+		assert.Equal(t, tokenExchangeSubjectTokenType, r.PostForm.Get("subject_token_type"))
+
+		if r.PostForm.Get("audience") != "sa-id" || r.PostForm.Get("subject_token") != "jwt" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_request","error_description":"bad token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"t1.iam-token","issued_token_type":"urn:ietf:params:oauth:token-type:access_token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	newCredentials := func(serviceAccountID string, source SubjectTokenSource) *oidcTokenExchangeCredentials {
+		c := NewOIDCTokenExchange(server.URL, serviceAccountID, source).(*oidcTokenExchangeCredentials)
+		c.now = func() time.Time { return now }
+		return c
+	}
+
+	t.Run("static token", func(t *testing.T) {
+		resp, err := newCredentials("sa-id", StaticSubjectToken("jwt")).IAMToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "t1.iam-token", resp.IamToken)
+		assert.Equal(t, now.Add(time.Hour), resp.ExpiresAt.AsTime())
+	})
+
+	t.Run("token file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(path, []byte("jwt\n"), 0600))
+
+		resp, err := newCredentials("sa-id", FileSubjectToken(path)).IAMToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "t1.iam-token", resp.IamToken)
+	})
+
+	t.Run("missing token file", func(t *testing.T) {
+		_, err := newCredentials("sa-id", FileSubjectToken(filepath.Join(t.TempDir(), "missing"))).IAMToken(context.Background())
+		assert.ErrorContains(t, err, "failed to read OIDC token file")
+	})
+
+	t.Run("exchange error", func(t *testing.T) {
+		_, err := newCredentials("other-sa-id", StaticSubjectToken("jwt")).IAMToken(context.Background())
+		assert.ErrorContains(t, err, "invalid_request: bad token")
+	})
+}
+
+func TestOIDCTokenExchangeRequiresServiceAccount(t *testing.T) {
+	_, err := OIDCTokenExchange("jwt", "", "", "")
+	assert.ErrorContains(t, err, "'oidc_service_account_id' should be specified")
+
+	creds, err := OIDCTokenExchange("jwt", "", "sa-id", "")
+	require.NoError(t, err)
+	assert.Equal(t, "sa-id", creds.(*oidcTokenExchangeCredentials).serviceAccountID)
+	assert.Equal(t, common.DefaultOIDCTokenExchangeEndpoint, creds.(*oidcTokenExchangeCredentials).endpoint)
+}
+
+func TestOIDCTokenExchangeCustomEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		_, _ = w.Write([]byte(`{"access_token":"t1.custom-endpoint-token","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	creds, err := OIDCTokenExchange("jwt", "", "sa-id", server.URL+"/oauth/token")
+	require.NoError(t, err)
+
+	resp, err := creds.(ycsdk.NonExchangeableCredentials).IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.custom-endpoint-token", resp.IamToken)
+}
@@ -0,0 +1,133 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultTokenLifetime is used for tokens, that are returned without expiration time.
+	// IAM token lives up to 12 hours, but it is recommended to request it more often.
+	defaultTokenLifetime = 1 * time.Hour
+
+	// tokenRefreshMargin is subtracted from the token expiration time,
+	// so the token is refreshed before API starts to reject it.
+	tokenRefreshMargin = 5 * time.Minute
+)
+
+// tokenOutput is the JSON format of `yc iam create-token --format json`.
+type tokenOutput struct {
+	IAMToken  string `json:"iam_token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type refreshableTokenCredentials struct {
+	source string
+	read   func(ctx context.Context) ([]byte, error)
+	now    func() time.Time
+}
+
+// NewTokenCommand returns credentials, that run the command in the shell every time the IAM token expires.
+// The command should print either IAM token or JSON in the format of `yc iam create-token --format json`.
+func NewTokenCommand(command string) ycsdk.NonExchangeableCredentials {
+	return &refreshableTokenCredentials{
+		source: fmt.Sprintf("token command %q", command),
+		read: func(ctx context.Context) ([]byte, error) {
+			return runTokenCommand(ctx, command)
+		},
+		now: time.Now,
+	}
+}
+
+// NewTokenFile returns credentials, that re-read the file every time the IAM token expires.
+// The file should contain either IAM token or JSON in the format of `yc iam create-token --format json`.
+func NewTokenFile(path string) ycsdk.NonExchangeableCredentials {
+	return &refreshableTokenCredentials{
+		source: fmt.Sprintf("token file %q", path),
+		read: func(_ context.Context) ([]byte, error) {
+			expanded, err := homedir.Expand(path)
+			if err != nil {
+				return nil, err
+			}
+			return os.ReadFile(expanded)
+		},
+		now: time.Now,
+	}
+}
+
+func (c *refreshableTokenCredentials) YandexCloudAPICredentials() {}
+
+func (c *refreshableTokenCredentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	readAt := c.now()
+	output, err := c.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM token from %s: %w", c.source, err)
+	}
+
+	resp, err := parseTokenOutput(output, readAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM token from %s: %w", c.source, err)
+	}
+	return resp, nil
+}
+
+func parseTokenOutput(output []byte, readAt time.Time) (*iam.CreateIamTokenResponse, error) {
+	output = bytes.TrimSpace(output)
+	if len(output) == 0 {
+		return nil, fmt.Errorf("token is empty")
+	}
+
+	token, expiresAt := string(output), readAt.Add(defaultTokenLifetime)
+	if output[0] == '{' {
+		var out tokenOutput
+		if err := json.Unmarshal(output, &out); err != nil {
+			return nil, fmt.Errorf("failed to parse token: %w", err)
+		}
+		if out.IAMToken == "" {
+			return nil, fmt.Errorf("'iam_token' is empty")
+		}
+		token = out.IAMToken
+
+		if out.ExpiresAt != "" {
+			t, err := time.Parse(time.RFC3339Nano, out.ExpiresAt)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse 'expires_at': %w", err)
+			}
+			expiresAt = t.Add(-tokenRefreshMargin)
+		}
+	}
+
+	return &iam.CreateIamTokenResponse{
+		IamToken:  token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+func runTokenCommand(ctx context.Context, command string) ([]byte, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,100 @@
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTokenOutput(t *testing.T) {
+	readAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name          string
+		output        string
+		expectedToken string
+		expectedExp   time.Time
+		expectedErr   string
+	}{
+		{
+			name:          "plain token",
+			output:        "t1.token\n",
+			expectedToken: "t1.token",
+			expectedExp:   readAt.Add(defaultTokenLifetime),
+		},
+		{
+			name:          "yc json output",
+			output:        `{"iam_token": "t1.token", "expires_at": "2024-01-01T20:00:00.123Z"}`,
+			expectedToken: "t1.token",
+			expectedExp:   time.Date(2024, 1, 1, 19, 55, 0, 123000000, time.UTC),
+		},
+		{
+			name:          "json output without expiration",
+			output:        `{"iam_token": "t1.token"}`,
+			expectedToken: "t1.token",
+			expectedExp:   readAt.Add(defaultTokenLifetime),
+		},
+		{
+			name:        "empty output",
+			output:      " \n",
+			expectedErr: "token is empty",
+		},
+		{
+			name:        "json output without token",
+			output:      `{"expires_at": "2024-01-01T20:00:00Z"}`,
+			expectedErr: "'iam_token' is empty",
+		},
+		{
+			name:        "invalid expiration",
+			output:      `{"iam_token": "t1.token", "expires_at": "tomorrow"}`,
+			expectedErr: "failed to parse 'expires_at'",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := parseTokenOutput([]byte(tc.output), readAt)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedToken, resp.IamToken)
+			assert.Equal(t, tc.expectedExp, resp.ExpiresAt.AsTime())
+		})
+	}
+}
+
+func TestTokenFileIsReRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	creds := NewTokenFile(path)
+
+	require.NoError(t, os.WriteFile(path, []byte("t1.first"), 0600))
+	resp, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.first", resp.IamToken)
+
+	require.NoError(t, os.WriteFile(path, []byte("t1.second"), 0600))
+	resp, err = creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.second", resp.IamToken)
+}
+
+func TestTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands use POSIX shell")
+	}
+
+	resp, err := NewTokenCommand("echo t1.token").IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.token", resp.IamToken)
+
+	_, err = NewTokenCommand("echo 'no credentials' >&2; exit 1").IAMToken(context.Background())
+	assert.ErrorContains(t, err, "no credentials")
+}
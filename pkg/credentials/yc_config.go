@@ -0,0 +1,116 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mitchellh/go-homedir"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultYCConfigFile is the path to the yc CLI configuration file.
+const DefaultYCConfigFile = "~/.config/yandex-cloud/config.yaml"
+
+// YCProfile holds the settings of yc CLI profile, which are used to configure the provider.
+type YCProfile struct {
+	Token string
+	// ServiceAccountKey is the service account key in JSON format.
+	ServiceAccountKey string
+	FederationID      string
+	// TokenCommand issues IAM token for profiles of federated accounts, since the provider
+	// can not pass the federation login itself and relies on yc CLI to do it.
+	TokenCommand string
+	CloudID      string
+	FolderID     string
+	Zone         string
+	Endpoint     string
+}
+
+type ycConfig struct {
+	Current  string                     `yaml:"current"`
+	Profiles map[string]ycConfigProfile `yaml:"profiles"`
+}
+
+type ycConfigProfile struct {
+	Token              string                 `yaml:"token"`
+	ServiceAccountKey  map[string]interface{} `yaml:"service-account-key"`
+	FederationID       string                 `yaml:"federation-id"`
+	CloudID            string                 `yaml:"cloud-id"`
+	FolderID           string                 `yaml:"folder-id"`
+	ComputeDefaultZone string                 `yaml:"compute-default-zone"`
+	Endpoint           string                 `yaml:"endpoint"`
+}
+
+// ExistingYCConfigFile returns the path to yc CLI configuration file, if it exists.
+func ExistingYCConfigFile() string {
+	path, err := homedir.Expand(DefaultYCConfigFile)
+	if err != nil {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// ReadYCProfile reads the profile from yc CLI configuration file. It returns nil profile without an error,
+// if the file is not in yc CLI configuration format, so it can be parsed as a shared credentials file.
+// The `default` profile falls back to the current profile of yc CLI.
+// Returned errors mention the path of the file, so they are reported as is.
+func ReadYCProfile(filename, profile string) (*YCProfile, error) {
+	path, err := homedir.Expand(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand path of shared credentials file %q: %w", filename, err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read shared credentials file %q, expected yc CLI configuration file "+
+			"or shared credentials file in INI format: %w", path, err)
+	}
+	ycProfile, err := parseYCProfile(content, profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read yc CLI configuration file %q: %w", path, err)
+	}
+	return ycProfile, nil
+}
+
+func parseYCProfile(content []byte, profile string) (*YCProfile, error) {
+	var config ycConfig
+	if err := yaml.Unmarshal(content, &config); err != nil || config.Profiles == nil {
+		return nil, nil
+	}
+
+	name := profile
+	p, ok := config.Profiles[name]
+	if !ok && profile == "default" && config.Current != "" {
+		name = config.Current
+		p, ok = config.Profiles[name]
+	}
+	if !ok {
+		return nil, fmt.Errorf("not found `%v` profile in yc CLI configuration file", profile)
+	}
+
+	result := &YCProfile{
+		Token:        p.Token,
+		FederationID: p.FederationID,
+		CloudID:      p.CloudID,
+		FolderID:     p.FolderID,
+		Zone:         p.ComputeDefaultZone,
+		Endpoint:     p.Endpoint,
+	}
+
+	if p.ServiceAccountKey != nil {
+		key, err := json.Marshal(p.ServiceAccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read service account key of `%v` profile: %w", name, err)
+		}
+		result.ServiceAccountKey = string(key)
+	}
+
+	if p.FederationID != "" && p.Token == "" && result.ServiceAccountKey == "" {
+		result.TokenCommand = fmt.Sprintf("yc iam create-token --profile %q", name)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package credentials
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testYCConfig = `current: dev
+profiles:
+  dev:
+    token: AQAAAAAA
+    cloud-id: dev-cloud-id
+    folder-id: dev-folder-id
+    compute-default-zone: ru-central1-a
+  prod:
+    service-account-key:
+      id: key-id
+      service_account_id: sa-id
+      created_at: "2024-01-01T00:00:00Z"
+      key_algorithm: RSA_2048
+      public_key: public
+      private_key: private
+    cloud-id: prod-cloud-id
+    folder-id: prod-folder-id
+    endpoint: api.example.com:443
+  federated:
+    federation-id: federation-id
+    cloud-id: fed-cloud-id
+`
+
+func TestParseYCProfile(t *testing.T) {
+	cases := []struct {
+		name            string
+		content         string
+		profile         string
+		expectedProfile *YCProfile
+		expectedError   string
+	}{
+		{
+			name:    "token profile",
+			content: testYCConfig,
+			profile: "dev",
+			expectedProfile: &YCProfile{
+				Token:    "AQAAAAAA",
+				CloudID:  "dev-cloud-id",
+				FolderID: "dev-folder-id",
+				Zone:     "ru-central1-a",
+			},
+		},
+		{
+			name:    "default profile falls back to current",
+			content: testYCConfig,
+			profile: "default",
+			expectedProfile: &YCProfile{
+				Token:    "AQAAAAAA",
+				CloudID:  "dev-cloud-id",
+				FolderID: "dev-folder-id",
+				Zone:     "ru-central1-a",
+			},
+		},
+		{
+			name:    "federated profile",
+			content: testYCConfig,
+			profile: "federated",
+			expectedProfile: &YCProfile{
+				FederationID: "federation-id",
+				TokenCommand: `yc iam create-token --profile "federated"`,
+				CloudID:      "fed-cloud-id",
+			},
+		},
+		{
+			name:          "missing profile",
+			content:       testYCConfig,
+			profile:       "stage",
+			expectedError: "not found `stage` profile in yc CLI configuration file",
+		},
+		{
+			name:    "shared credentials file",
+			content: "[prod]\nstorage_access_key=access-key\nstorage_secret_key=secret-key",
+			profile: "prod",
+		},
+		{
+			name:    "empty file",
+			content: "",
+			profile: "prod",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile, err := parseYCProfile([]byte(tc.content), tc.profile)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedProfile, profile)
+		})
+	}
+}
+
+func TestParseYCProfileServiceAccountKey(t *testing.T) {
+	profile, err := parseYCProfile([]byte(testYCConfig), "prod")
+	require.NoError(t, err)
+
+	assert.Equal(t, "prod-cloud-id", profile.CloudID)
+	assert.Equal(t, "api.example.com:443", profile.Endpoint)
+	assert.Empty(t, profile.TokenCommand)
+
+	var key map[string]string
+	require.NoError(t, json.Unmarshal([]byte(profile.ServiceAccountKey), &key))
+	assert.Equal(t, "key-id", key["id"])
+	assert.Equal(t, "sa-id", key["service_account_id"])
+	assert.Equal(t, "private", key["private_key"])
+}
@@ -0,0 +1,24 @@
+package lockboxpayload
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+// Entry is an entry of a Lockbox secret version payload, as it is exposed by the yandex_lockbox_secret_version
+// data source and ephemeral resource.
+type Entry struct {
+	Key       types.String `tfsdk:"key"`
+	TextValue types.String `tfsdk:"text_value"`
+}
+
+func FlattenEntries(entries []*lockbox.Payload_Entry) []Entry {
+	result := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, Entry{
+			Key:       types.StringValue(e.GetKey()),
+			TextValue: types.StringValue(e.GetTextValue()),
+		})
+	}
+	return result
+}
@@ -0,0 +1,22 @@
+package lockboxpayload
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+func TestFlattenEntries(t *testing.T) {
+	entries := FlattenEntries([]*lockbox.Payload_Entry{
+		{Key: "password", Value: &lockbox.Payload_Entry_TextValue{TextValue: "secret"}},
+		{Key: "key.bin", Value: &lockbox.Payload_Entry_BinaryValue{BinaryValue: []byte{1, 2}}},
+	})
+
+	assert.Equal(t, []Entry{
+		{Key: types.StringValue("password"), TextValue: types.StringValue("secret")},
+		{Key: types.StringValue("key.bin"), TextValue: types.StringValue("")},
+	}, entries)
+	assert.Empty(t, FlattenEntries(nil))
+}
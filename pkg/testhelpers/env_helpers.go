@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
@@ -30,6 +31,10 @@ import (
 var AccProviders map[string]tfprotov6.ProviderServer
 var AccProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 
+// AccEphemeralProviderFactories additionally contains the echo provider, which is used
+// to check values of ephemeral resources, since they are never persisted into the state.
+var AccEphemeralProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
 // WARNING!!!! do not use testAccProviderEmptyFolder in tests, that use testAccCheck***Destroy functions.
 // testAccCheck***Destroy functions tend to use static testAccProviderServer
 var testAccProviderEmptyFolder map[string]tfprotov6.ProviderServer
@@ -92,6 +97,10 @@ func init() {
 			return testAccProviderServer, nil
 		},
 	}
+	AccEphemeralProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"yandex": AccProviderFactories["yandex"],
+		"echo":   echoprovider.NewProviderServer(),
+	}
 
 	if os.Getenv("TF_ACC") != "" {
 		if err := setTestIDs(); err != nil {
@@ -0,0 +1,136 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// AccessBindings returns access bindings of the resource.
+func (s *Server) AccessBindings(resourceID string) []*access.AccessBinding {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return cloneAccessBindings(s.accessBindings[resourceID])
+}
+
+func cloneAccessBindings(bindings []*access.AccessBinding) []*access.AccessBinding {
+	out := make([]*access.AccessBinding, 0, len(bindings))
+	for _, b := range bindings {
+		out = append(out, proto.Clone(b).(*access.AccessBinding))
+	}
+	return out
+}
+
+func sameAccessBinding(a, b *access.AccessBinding) bool {
+	return a.RoleId == b.RoleId && a.Subject.GetType() == b.Subject.GetType() && a.Subject.GetId() == b.Subject.GetId()
+}
+
+// accessBindingsService implements access bindings methods, which are the same for all services,
+// services delegate their ListAccessBindings, SetAccessBindings and UpdateAccessBindings to it.
+// Resources are checked with exists, which must be called with the server lock held.
+type accessBindingsService struct {
+	server *Server
+	kind   string
+	exists func(id string) bool
+}
+
+func (a *accessBindingsService) list(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+
+	if !a.exists(req.ResourceId) {
+		return nil, notFound(a.kind, req.ResourceId)
+	}
+	return &access.ListAccessBindingsResponse{
+		AccessBindings: cloneAccessBindings(a.server.accessBindings[req.ResourceId]),
+	}, nil
+}
+
+func (a *accessBindingsService) set(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+
+	if !a.exists(req.ResourceId) {
+		return nil, notFound(a.kind, req.ResourceId)
+	}
+	a.server.accessBindings[req.ResourceId] = cloneAccessBindings(req.AccessBindings)
+
+	return a.server.newOperation("Set access bindings", &access.SetAccessBindingsMetadata{ResourceId: req.ResourceId}, nil)
+}
+
+func (a *accessBindingsService) update(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+
+	if !a.exists(req.ResourceId) {
+		return nil, notFound(a.kind, req.ResourceId)
+	}
+
+	bindings := a.server.accessBindings[req.ResourceId]
+	for _, delta := range req.AccessBindingDeltas {
+		index := -1
+		for i, b := range bindings {
+			if sameAccessBinding(b, delta.AccessBinding) {
+				index = i
+				break
+			}
+		}
+
+		switch delta.Action {
+		case access.AccessBindingAction_ADD:
+			if index == -1 {
+				bindings = append(bindings, proto.Clone(delta.AccessBinding).(*access.AccessBinding))
+			}
+		case access.AccessBindingAction_REMOVE:
+			if index != -1 {
+				bindings = append(bindings[:index], bindings[index+1:]...)
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported access binding action %s", delta.Action)
+		}
+	}
+	a.server.accessBindings[req.ResourceId] = bindings
+
+	return a.server.newOperation("Update access bindings", &access.UpdateAccessBindingsMetadata{ResourceId: req.ResourceId}, nil)
+}
+
+func (c *cloudService) ListAccessBindings(ctx context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	return c.accessBindings.list(ctx, req)
+}
+
+func (c *cloudService) SetAccessBindings(ctx context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	return c.accessBindings.set(ctx, req)
+}
+
+func (c *cloudService) UpdateAccessBindings(ctx context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	return c.accessBindings.update(ctx, req)
+}
+
+func (d *diskService) ListAccessBindings(ctx context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	return d.accessBindings.list(ctx, req)
+}
+
+func (d *diskService) SetAccessBindings(ctx context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	return d.accessBindings.set(ctx, req)
+}
+
+func (d *diskService) UpdateAccessBindings(ctx context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	return d.accessBindings.update(ctx, req)
+}
+
+func (f *folderService) ListAccessBindings(ctx context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	return f.accessBindings.list(ctx, req)
+}
+
+func (f *folderService) SetAccessBindings(ctx context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	return f.accessBindings.set(ctx, req)
+}
+
+func (f *folderService) UpdateAccessBindings(ctx context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	return f.accessBindings.update(ctx, req)
+}
@@ -0,0 +1,133 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDiskType      = "network-hdd"
+	defaultDiskBlockSize = 4096
+)
+
+type diskService struct {
+	compute.UnimplementedDiskServiceServer
+	server         *Server
+	accessBindings accessBindingsService
+}
+
+func (d *diskService) Get(_ context.Context, req *compute.GetDiskRequest) (*compute.Disk, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	disk, ok := d.server.disks[req.DiskId]
+	if !ok {
+		return nil, notFound("Disk", req.DiskId)
+	}
+	return proto.Clone(disk).(*compute.Disk), nil
+}
+
+func (d *diskService) List(_ context.Context, req *compute.ListDisksRequest) (*compute.ListDisksResponse, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	disks, err := list(d.server.disks, req.FolderId, req.Filter, (*compute.Disk).GetFolderId, (*compute.Disk).GetName)
+	if err != nil {
+		return nil, err
+	}
+	return &compute.ListDisksResponse{Disks: disks}, nil
+}
+
+func (d *diskService) Create(_ context.Context, req *compute.CreateDiskRequest) (*operation.Operation, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	if err := d.server.checkFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+	if req.Size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size must be positive")
+	}
+
+	disk := &compute.Disk{
+		Id:                  d.server.newID("fhm"),
+		FolderId:            req.FolderId,
+		CreatedAt:           timestamppb.Now(),
+		Name:                req.Name,
+		Description:         req.Description,
+		Labels:              req.Labels,
+		TypeId:              req.TypeId,
+		ZoneId:              req.ZoneId,
+		Size:                req.Size,
+		BlockSize:           req.BlockSize,
+		Status:              compute.Disk_READY,
+		DiskPlacementPolicy: req.DiskPlacementPolicy,
+		HardwareGeneration:  req.HardwareGeneration,
+	}
+	if disk.TypeId == "" {
+		disk.TypeId = defaultDiskType
+	}
+	if disk.BlockSize == 0 {
+		disk.BlockSize = defaultDiskBlockSize
+	}
+	if disk.DiskPlacementPolicy == nil {
+		disk.DiskPlacementPolicy = &compute.DiskPlacementPolicy{}
+	}
+	switch source := req.Source.(type) {
+	case *compute.CreateDiskRequest_ImageId:
+		disk.Source = &compute.Disk_SourceImageId{SourceImageId: source.ImageId}
+	case *compute.CreateDiskRequest_SnapshotId:
+		disk.Source = &compute.Disk_SourceSnapshotId{SourceSnapshotId: source.SnapshotId}
+	}
+	d.server.disks[disk.Id] = disk
+
+	return d.server.newOperation("Create disk", &compute.CreateDiskMetadata{DiskId: disk.Id}, disk)
+}
+
+func (d *diskService) Update(_ context.Context, req *compute.UpdateDiskRequest) (*operation.Operation, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	disk, ok := d.server.disks[req.DiskId]
+	if !ok {
+		return nil, notFound("Disk", req.DiskId)
+	}
+	if req.Size != 0 && req.Size < disk.Size {
+		return nil, status.Error(codes.InvalidArgument, "disk size can not be decreased")
+	}
+	err := applyUpdateMask(req.UpdateMask, map[string]func(){
+		"name":                  func() { disk.Name = req.Name },
+		"description":           func() { disk.Description = req.Description },
+		"labels":                func() { disk.Labels = req.Labels },
+		"size":                  func() { disk.Size = req.Size },
+		"disk_placement_policy": func() { disk.DiskPlacementPolicy = req.DiskPlacementPolicy },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return d.server.newOperation("Update disk", &compute.UpdateDiskMetadata{DiskId: disk.Id}, disk)
+}
+
+func (d *diskService) Delete(_ context.Context, req *compute.DeleteDiskRequest) (*operation.Operation, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	disk, ok := d.server.disks[req.DiskId]
+	if !ok {
+		return nil, notFound("Disk", req.DiskId)
+	}
+	if len(disk.InstanceIds) != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Disk %s is attached to instances", req.DiskId)
+	}
+	delete(d.server.disks, req.DiskId)
+	delete(d.server.accessBindings, req.DiskId)
+
+	return d.server.newOperation("Delete disk", &compute.DeleteDiskMetadata{DiskId: req.DiskId}, nil)
+}
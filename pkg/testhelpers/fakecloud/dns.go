@@ -0,0 +1,206 @@
+package fakecloud
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultRecordSetsPageSize = 100
+	// maxUpsertRecordSets is the limit of deletions, replacements and merges in UpsertRecordSets request.
+	maxUpsertRecordSets = 1000
+)
+
+type dnsZoneService struct {
+	dns.UnimplementedDnsZoneServiceServer
+	server *Server
+}
+
+func (d *dnsZoneService) Get(_ context.Context, req *dns.GetDnsZoneRequest) (*dns.DnsZone, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	zone, ok := d.server.dnsZones[req.DnsZoneId]
+	if !ok {
+		return nil, notFound("DnsZone", req.DnsZoneId)
+	}
+	return proto.Clone(zone).(*dns.DnsZone), nil
+}
+
+// Create creates the zone with SOA and NS record sets at its apex, like the service does.
+func (d *dnsZoneService) Create(_ context.Context, req *dns.CreateDnsZoneRequest) (*operation.Operation, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	if err := d.server.checkFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(req.Zone, ".") {
+		return nil, status.Errorf(codes.InvalidArgument, "zone %q must end with a dot", req.Zone)
+	}
+
+	zone := &dns.DnsZone{
+		Id:                 d.server.newID("dns"),
+		FolderId:           req.FolderId,
+		CreatedAt:          timestamppb.Now(),
+		Name:               req.Name,
+		Description:        req.Description,
+		Labels:             req.Labels,
+		Zone:               req.Zone,
+		PrivateVisibility:  req.PrivateVisibility,
+		PublicVisibility:   req.PublicVisibility,
+		DeletionProtection: req.DeletionProtection,
+	}
+	d.server.dnsZones[zone.Id] = zone
+	d.server.recordSets[zone.Id] = []*dns.RecordSet{
+		{Name: req.Zone, Type: "NS", Ttl: 3600, Data: []string{"ns1.yandexcloud.net.", "ns2.yandexcloud.net."}},
+		{Name: req.Zone, Type: "SOA", Ttl: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900"}},
+	}
+
+	return d.server.newOperation("Create DNS zone", &dns.CreateDnsZoneMetadata{DnsZoneId: zone.Id}, zone)
+}
+
+func (d *dnsZoneService) Delete(_ context.Context, req *dns.DeleteDnsZoneRequest) (*operation.Operation, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	if _, ok := d.server.dnsZones[req.DnsZoneId]; !ok {
+		return nil, notFound("DnsZone", req.DnsZoneId)
+	}
+	delete(d.server.dnsZones, req.DnsZoneId)
+	delete(d.server.recordSets, req.DnsZoneId)
+
+	return d.server.newOperation("Delete DNS zone", &dns.DeleteDnsZoneMetadata{DnsZoneId: req.DnsZoneId}, nil)
+}
+
+// ListRecordSets lists record sets ordered by name and type. Page token is the index of the first record set.
+func (d *dnsZoneService) ListRecordSets(_ context.Context, req *dns.ListDnsZoneRecordSetsRequest) (*dns.ListDnsZoneRecordSetsResponse, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	if _, ok := d.server.dnsZones[req.DnsZoneId]; !ok {
+		return nil, notFound("DnsZone", req.DnsZoneId)
+	}
+	if req.Filter != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported filter %q", req.Filter)
+	}
+
+	recordSets := d.server.recordSets[req.DnsZoneId]
+	start := 0
+	if req.PageToken != "" {
+		var err error
+		start, err = strconv.Atoi(req.PageToken)
+		if err != nil || start < 0 || start > len(recordSets) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", req.PageToken)
+		}
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultRecordSetsPageSize
+	}
+	end := min(start+pageSize, len(recordSets))
+
+	resp := &dns.ListDnsZoneRecordSetsResponse{}
+	for _, rs := range recordSets[start:end] {
+		resp.RecordSets = append(resp.RecordSets, proto.Clone(rs).(*dns.RecordSet))
+	}
+	if end < len(recordSets) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+// UpsertRecordSets applies deletions, replacements and merges in this order. Names are stored as is,
+// so callers are expected to send fully qualified names.
+func (d *dnsZoneService) UpsertRecordSets(_ context.Context, req *dns.UpsertRecordSetsRequest) (*operation.Operation, error) {
+	d.server.mu.Lock()
+	defer d.server.mu.Unlock()
+
+	if _, ok := d.server.dnsZones[req.DnsZoneId]; !ok {
+		return nil, notFound("DnsZone", req.DnsZoneId)
+	}
+	for field, n := range map[string]int{"deletions": len(req.Deletions), "replacements": len(req.Replacements), "merges": len(req.Merges)} {
+		if n > maxUpsertRecordSets {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must contain at most %d record sets, got %d", field, maxUpsertRecordSets, n)
+		}
+	}
+
+	recordSets := map[[2]string]*dns.RecordSet{}
+	for _, rs := range d.server.recordSets[req.DnsZoneId] {
+		recordSets[[2]string{rs.Name, rs.Type}] = rs
+	}
+
+	diff := &dns.RecordSetDiff{}
+	for _, rs := range req.Deletions {
+		key := [2]string{rs.Name, rs.Type}
+		existing, ok := recordSets[key]
+		if !ok {
+			continue
+		}
+		data := slices.DeleteFunc(slices.Clone(existing.Data), func(v string) bool { return slices.Contains(rs.Data, v) })
+		diff.Deletions = append(diff.Deletions, proto.Clone(existing).(*dns.RecordSet))
+		if len(data) == 0 {
+			delete(recordSets, key)
+			continue
+		}
+		recordSets[key] = &dns.RecordSet{Name: rs.Name, Type: rs.Type, Ttl: existing.Ttl, Data: data}
+		diff.Additions = append(diff.Additions, proto.Clone(recordSets[key]).(*dns.RecordSet))
+	}
+	for _, rs := range req.Replacements {
+		key := [2]string{rs.Name, rs.Type}
+		if existing, ok := recordSets[key]; ok {
+			diff.Deletions = append(diff.Deletions, proto.Clone(existing).(*dns.RecordSet))
+		}
+		recordSets[key] = proto.Clone(rs).(*dns.RecordSet)
+		diff.Additions = append(diff.Additions, proto.Clone(rs).(*dns.RecordSet))
+	}
+	for _, rs := range req.Merges {
+		key := [2]string{rs.Name, rs.Type}
+		merged := proto.Clone(rs).(*dns.RecordSet)
+		if existing, ok := recordSets[key]; ok {
+			diff.Deletions = append(diff.Deletions, proto.Clone(existing).(*dns.RecordSet))
+			for _, v := range existing.Data {
+				if !slices.Contains(merged.Data, v) {
+					merged.Data = append(merged.Data, v)
+				}
+			}
+		}
+		recordSets[key] = merged
+		diff.Additions = append(diff.Additions, proto.Clone(merged).(*dns.RecordSet))
+	}
+
+	result := make([]*dns.RecordSet, 0, len(recordSets))
+	for _, rs := range recordSets {
+		result = append(result, rs)
+	}
+	slices.SortFunc(result, func(a, b *dns.RecordSet) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Type, b.Type)
+	})
+	d.server.recordSets[req.DnsZoneId] = result
+
+	return d.server.newOperation("Upsert record sets", &dns.UpsertRecordSetsMetadata{}, diff)
+}
+
+// RecordSets returns record sets of the DNS zone, it is used to check the state of the fake in tests.
+func (s *Server) RecordSets(zoneID string) []*dns.RecordSet {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]*dns.RecordSet, 0, len(s.recordSets[zoneID]))
+	for _, rs := range s.recordSets[zoneID] {
+		result = append(result, proto.Clone(rs).(*dns.RecordSet))
+	}
+	return result
+}
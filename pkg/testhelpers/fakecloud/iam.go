@@ -0,0 +1,140 @@
+package fakecloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type serviceAccountService struct {
+	iam.UnimplementedServiceAccountServiceServer
+	server *Server
+}
+
+func (s *serviceAccountService) Get(_ context.Context, req *iam.GetServiceAccountRequest) (*iam.ServiceAccount, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	sa, ok := s.server.serviceAccounts[req.ServiceAccountId]
+	if !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+	return proto.Clone(sa).(*iam.ServiceAccount), nil
+}
+
+func (s *serviceAccountService) List(_ context.Context, req *iam.ListServiceAccountsRequest) (*iam.ListServiceAccountsResponse, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	serviceAccounts, err := list(s.server.serviceAccounts, req.FolderId, req.Filter, (*iam.ServiceAccount).GetFolderId, (*iam.ServiceAccount).GetName)
+	if err != nil {
+		return nil, err
+	}
+	return &iam.ListServiceAccountsResponse{ServiceAccounts: serviceAccounts}, nil
+}
+
+func (s *serviceAccountService) Create(_ context.Context, req *iam.CreateServiceAccountRequest) (*operation.Operation, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	if err := s.server.checkFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+
+	sa := &iam.ServiceAccount{
+		Id:          s.server.newID("aje"),
+		FolderId:    req.FolderId,
+		CreatedAt:   timestamppb.Now(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+	}
+	s.server.serviceAccounts[sa.Id] = sa
+
+	return s.server.newOperation("Create service account", &iam.CreateServiceAccountMetadata{ServiceAccountId: sa.Id}, sa)
+}
+
+func (s *serviceAccountService) Update(_ context.Context, req *iam.UpdateServiceAccountRequest) (*operation.Operation, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	sa, ok := s.server.serviceAccounts[req.ServiceAccountId]
+	if !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+	err := applyUpdateMask(req.UpdateMask, map[string]func(){
+		"name":        func() { sa.Name = req.Name },
+		"description": func() { sa.Description = req.Description },
+		"labels":      func() { sa.Labels = req.Labels },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.server.newOperation("Update service account", &iam.UpdateServiceAccountMetadata{ServiceAccountId: sa.Id}, sa)
+}
+
+func (s *serviceAccountService) Delete(_ context.Context, req *iam.DeleteServiceAccountRequest) (*operation.Operation, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	if _, ok := s.server.serviceAccounts[req.ServiceAccountId]; !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+	delete(s.server.serviceAccounts, req.ServiceAccountId)
+
+	return s.server.newOperation("Delete service account", &iam.DeleteServiceAccountMetadata{ServiceAccountId: req.ServiceAccountId}, nil)
+}
+
+// iamTokenService issues tokens, which are not checked by the fake.
+type iamTokenService struct {
+	iam.UnimplementedIamTokenServiceServer
+	server *Server
+}
+
+func (i *iamTokenService) Create(context.Context, *iam.CreateIamTokenRequest) (*iam.CreateIamTokenResponse, error) {
+	return newIAMToken(), nil
+}
+
+func (i *iamTokenService) CreateForServiceAccount(_ context.Context, req *iam.CreateIamTokenForServiceAccountRequest) (*iam.CreateIamTokenResponse, error) {
+	i.server.mu.Lock()
+	defer i.server.mu.Unlock()
+
+	if _, ok := i.server.serviceAccounts[req.ServiceAccountId]; !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+	resp := newIAMToken()
+	resp.IamToken = ServiceAccountToken(req.ServiceAccountId)
+	return resp, nil
+}
+
+func newIAMToken() *iam.CreateIamTokenResponse {
+	return &iam.CreateIamTokenResponse{
+		IamToken:  Token,
+		ExpiresAt: timestamppb.New(time.Now().Add(12 * time.Hour)),
+	}
+}
+
+// ServiceAccountToken returns an IAM token, which is issued by the fake for the service account.
+func ServiceAccountToken(serviceAccountID string) string {
+	return Token + "." + serviceAccountID
+}
+
+// AddServiceAccount creates a service account in the default folder and returns its ID.
+func (s *Server) AddServiceAccount(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sa := &iam.ServiceAccount{
+		Id:        s.newID("aje"),
+		FolderId:  FolderID,
+		CreatedAt: timestamppb.Now(),
+		Name:      name,
+	}
+	s.serviceAccounts[sa.Id] = sa
+	return sa.Id
+}
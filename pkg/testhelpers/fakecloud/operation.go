@@ -0,0 +1,89 @@
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type operationService struct {
+	operation.UnimplementedOperationServiceServer
+	server *Server
+}
+
+// Get completes the operation, so the callers observe it running exactly once.
+func (o *operationService) Get(_ context.Context, req *operation.GetOperationRequest) (*operation.Operation, error) {
+	o.server.mu.Lock()
+	defer o.server.mu.Unlock()
+
+	op, ok := o.server.operations[req.OperationId]
+	if !ok {
+		return nil, notFound("Operation", req.OperationId)
+	}
+	if !op.Done {
+		op.Done = true
+		op.ModifiedAt = timestamppb.Now()
+	}
+	return proto.Clone(op).(*operation.Operation), nil
+}
+
+// newOperation registers a running operation, which results in the response when completed.
+// Changes of the state are applied immediately by the caller.
+// It must be called with the server lock held.
+func (s *Server) newOperation(description string, metadata, response proto.Message) (*operation.Operation, error) {
+	if response == nil {
+		response = &emptypb.Empty{}
+	}
+	md, err := anypb.New(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal operation metadata: %s", err)
+	}
+	resp, err := anypb.New(response)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal operation response: %s", err)
+	}
+
+	now := timestamppb.Now()
+	op := &operation.Operation{
+		Id:          s.newID("fop"),
+		Description: description,
+		CreatedAt:   now,
+		CreatedBy:   "fake",
+		ModifiedAt:  now,
+		Metadata:    md,
+		Result:      &operation.Operation_Response{Response: resp},
+	}
+	s.operations[op.Id] = op
+
+	running := proto.Clone(op).(*operation.Operation)
+	running.Result = nil
+	return running, nil
+}
+
+// applyUpdateMask calls setters of the fields listed in the mask, or of all fields when the mask is empty.
+func applyUpdateMask(mask *field_mask.FieldMask, setters map[string]func()) error {
+	if len(mask.GetPaths()) == 0 {
+		for _, set := range setters {
+			set()
+		}
+		return nil
+	}
+
+	for _, path := range mask.GetPaths() {
+		if _, ok := setters[path]; !ok {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported update mask path %q", path))
+		}
+	}
+	for _, path := range mask.GetPaths() {
+		setters[path]()
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package fakecloud
+
+import (
+	"context"
+	"sort"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type cloudService struct {
+	resourcemanager.UnimplementedCloudServiceServer
+	server         *Server
+	accessBindings accessBindingsService
+}
+
+func (c *cloudService) Get(_ context.Context, req *resourcemanager.GetCloudRequest) (*resourcemanager.Cloud, error) {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+
+	cloud, ok := c.server.clouds[req.CloudId]
+	if !ok {
+		return nil, notFound("Cloud", req.CloudId)
+	}
+	return proto.Clone(cloud).(*resourcemanager.Cloud), nil
+}
+
+func (c *cloudService) List(_ context.Context, req *resourcemanager.ListCloudsRequest) (*resourcemanager.ListCloudsResponse, error) {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+
+	ids := make([]string, 0, len(c.server.clouds))
+	for id := range c.server.clouds {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	resp := &resourcemanager.ListCloudsResponse{}
+	for _, id := range ids {
+		cloud := c.server.clouds[id]
+		ok, err := matchFilter(req.Filter, cloud.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp.Clouds = append(resp.Clouds, proto.Clone(cloud).(*resourcemanager.Cloud))
+		}
+	}
+	return resp, nil
+}
+
+type folderService struct {
+	resourcemanager.UnimplementedFolderServiceServer
+	server         *Server
+	accessBindings accessBindingsService
+}
+
+func (f *folderService) Get(_ context.Context, req *resourcemanager.GetFolderRequest) (*resourcemanager.Folder, error) {
+	f.server.mu.Lock()
+	defer f.server.mu.Unlock()
+
+	folder, ok := f.server.folders[req.FolderId]
+	if !ok {
+		return nil, notFound("Folder", req.FolderId)
+	}
+	return proto.Clone(folder).(*resourcemanager.Folder), nil
+}
+
+func (f *folderService) List(_ context.Context, req *resourcemanager.ListFoldersRequest) (*resourcemanager.ListFoldersResponse, error) {
+	f.server.mu.Lock()
+	defer f.server.mu.Unlock()
+
+	folders, err := list(f.server.folders, req.CloudId, req.Filter, (*resourcemanager.Folder).GetCloudId, (*resourcemanager.Folder).GetName)
+	if err != nil {
+		return nil, err
+	}
+	return &resourcemanager.ListFoldersResponse{Folders: folders}, nil
+}
+
+func (f *folderService) Create(_ context.Context, req *resourcemanager.CreateFolderRequest) (*operation.Operation, error) {
+	f.server.mu.Lock()
+	defer f.server.mu.Unlock()
+
+	if _, ok := f.server.clouds[req.CloudId]; !ok {
+		return nil, notFound("Cloud", req.CloudId)
+	}
+
+	folder := &resourcemanager.Folder{
+		Id:          f.server.newID("b1g"),
+		CloudId:     req.CloudId,
+		CreatedAt:   timestamppb.Now(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		Status:      resourcemanager.Folder_ACTIVE,
+	}
+	f.server.folders[folder.Id] = folder
+
+	return f.server.newOperation("Create folder", &resourcemanager.CreateFolderMetadata{FolderId: folder.Id}, folder)
+}
+
+func (f *folderService) Update(_ context.Context, req *resourcemanager.UpdateFolderRequest) (*operation.Operation, error) {
+	f.server.mu.Lock()
+	defer f.server.mu.Unlock()
+
+	folder, ok := f.server.folders[req.FolderId]
+	if !ok {
+		return nil, notFound("Folder", req.FolderId)
+	}
+	err := applyUpdateMask(req.UpdateMask, map[string]func(){
+		"name":        func() { folder.Name = req.Name },
+		"description": func() { folder.Description = req.Description },
+		"labels":      func() { folder.Labels = req.Labels },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return f.server.newOperation("Update folder", &resourcemanager.UpdateFolderMetadata{FolderId: folder.Id}, folder)
+}
+
+func (f *folderService) Delete(_ context.Context, req *resourcemanager.DeleteFolderRequest) (*operation.Operation, error) {
+	f.server.mu.Lock()
+	defer f.server.mu.Unlock()
+
+	if _, ok := f.server.folders[req.FolderId]; !ok {
+		return nil, notFound("Folder", req.FolderId)
+	}
+	delete(f.server.folders, req.FolderId)
+
+	return f.server.newOperation("Delete folder", &resourcemanager.DeleteFolderMetadata{FolderId: req.FolderId}, nil)
+}
@@ -0,0 +1,310 @@
+// Package fakecloud provides an in-process fake of Yandex Cloud gRPC API for provider unit tests.
+//
+// The fake implements the subset of compute, vpc, iam, dns, resourcemanager and operation services
+// that is used by the corresponding resources, including access bindings of clouds, folders and disks,
+// keeps all the state in memory and completes every long-running operation on its first poll.
+// Provider is pointed at the fake with `endpoint` and `plaintext` attributes, see Server.ProviderConfig.
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// CloudID is the ID of the cloud, which exists in every fake.
+	CloudID = "b1gfakecloud0000000"
+	// FolderID is the ID of the folder, which exists in every fake and is used by the provider by default.
+	FolderID = "b1gfakefolder000000"
+	// Zone is the default availability zone of the provider.
+	Zone = "ru-central1-a"
+	// Token is an IAM token, which is accepted by the fake.
+	Token = "t1.fake.token"
+)
+
+// serviceIDs are the IDs of API endpoints resolved by the SDK. All of them point to the fake,
+// services, which are not implemented, respond with codes.Unimplemented.
+var serviceIDs = []string{
+	"compute", "iam", "operation", "organization-manager", "resource-manager", "storage", "storage-api",
+	"monitoring", "serialssh", "endpoint", "vpc", "managed-kubernetes", "dns", "ydb", "backup", "audittrails",
+}
+
+// Server is an in-process fake of Yandex Cloud API.
+type Server struct {
+	addr string
+	grpc *grpc.Server
+
+	mu         sync.Mutex
+	idCounter  int
+	operations map[string]*operation.Operation
+
+	clouds          map[string]*resourcemanager.Cloud
+	folders         map[string]*resourcemanager.Folder
+	networks        map[string]*vpc.Network
+	subnets         map[string]*vpc.Subnet
+	serviceAccounts map[string]*iam.ServiceAccount
+	disks           map[string]*compute.Disk
+	accessBindings  map[string][]*access.AccessBinding
+	dnsZones        map[string]*dns.DnsZone
+	recordSets      map[string][]*dns.RecordSet
+
+	// requestTokens are IAM tokens of the last requests by full method name.
+	requestTokens map[string]string
+}
+
+// New starts a fake on a random local port. The fake is stopped, when the test finishes.
+func New(t testing.TB) *Server {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start fake Yandex Cloud API: %s", err)
+	}
+
+	s := NewServer(listener.Addr().String())
+	go func() {
+		_ = s.grpc.Serve(listener)
+	}()
+	t.Cleanup(s.grpc.Stop)
+
+	return s
+}
+
+// NewServer creates a fake with registered services. It is served by the caller, e.g. with Serve.
+func NewServer(addr string) *Server {
+	s := &Server{
+		addr:            addr,
+		operations:      map[string]*operation.Operation{},
+		clouds:          map[string]*resourcemanager.Cloud{},
+		folders:         map[string]*resourcemanager.Folder{},
+		networks:        map[string]*vpc.Network{},
+		subnets:         map[string]*vpc.Subnet{},
+		serviceAccounts: map[string]*iam.ServiceAccount{},
+		disks:           map[string]*compute.Disk{},
+		accessBindings:  map[string][]*access.AccessBinding{},
+		dnsZones:        map[string]*dns.DnsZone{},
+		recordSets:      map[string][]*dns.RecordSet{},
+		requestTokens:   map[string]string{},
+	}
+	s.grpc = grpc.NewServer(grpc.UnaryInterceptor(s.recordRequestToken))
+
+	s.clouds[CloudID] = &resourcemanager.Cloud{
+		Id:        CloudID,
+		CreatedAt: timestamppb.Now(),
+		Name:      "fake-cloud",
+	}
+	s.folders[FolderID] = &resourcemanager.Folder{
+		Id:        FolderID,
+		CloudId:   CloudID,
+		CreatedAt: timestamppb.Now(),
+		Name:      "fake-folder",
+		Status:    resourcemanager.Folder_ACTIVE,
+	}
+
+	endpoint.RegisterApiEndpointServiceServer(s.grpc, &endpointService{server: s})
+	operation.RegisterOperationServiceServer(s.grpc, &operationService{server: s})
+	resourcemanager.RegisterCloudServiceServer(s.grpc, &cloudService{
+		server:         s,
+		accessBindings: accessBindingsService{server: s, kind: "Cloud", exists: func(id string) bool { return s.clouds[id] != nil }},
+	})
+	resourcemanager.RegisterFolderServiceServer(s.grpc, &folderService{
+		server:         s,
+		accessBindings: accessBindingsService{server: s, kind: "Folder", exists: func(id string) bool { return s.folders[id] != nil }},
+	})
+	vpc.RegisterNetworkServiceServer(s.grpc, &networkService{server: s})
+	vpc.RegisterSubnetServiceServer(s.grpc, &subnetService{server: s})
+	iam.RegisterServiceAccountServiceServer(s.grpc, &serviceAccountService{server: s})
+	iam.RegisterIamTokenServiceServer(s.grpc, &iamTokenService{server: s})
+	compute.RegisterDiskServiceServer(s.grpc, &diskService{
+		server:         s,
+		accessBindings: accessBindingsService{server: s, kind: "Disk", exists: func(id string) bool { return s.disks[id] != nil }},
+	})
+	dns.RegisterDnsZoneServiceServer(s.grpc, &dnsZoneService{server: s})
+
+	return s
+}
+
+// RequestToken returns IAM token of the last request to the method, e.g. "/yandex.cloud.vpc.v1.NetworkService/Get".
+// Tokens are not checked by the fake, it is used to check which credentials are used by the provider.
+func (s *Server) RequestToken(method string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requestTokens[method]
+}
+
+func (s *Server) recordRequestToken(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			s.mu.Lock()
+			s.requestTokens[info.FullMethod] = strings.TrimPrefix(values[0], "Bearer ")
+			s.mu.Unlock()
+		}
+	}
+	return handler(ctx, req)
+}
+
+// Serve accepts connections on the listener until the fake is stopped.
+func (s *Server) Serve(listener net.Listener) error {
+	return s.grpc.Serve(listener)
+}
+
+// Endpoint returns the address of the fake, which is used as provider `endpoint`.
+func (s *Server) Endpoint() string {
+	return s.addr
+}
+
+// ProviderConfig returns provider configuration block, that points the provider to the fake.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "yandex" {
+  endpoint  = %q
+  plaintext = true
+  token     = %q
+  cloud_id  = %q
+  folder_id = %q
+  zone      = %q
+}
+`, s.addr, Token, CloudID, FolderID, Zone)
+}
+
+// IsolateEnv makes sure, that settings from the environment of acceptance tests, e.g. credentials,
+// do not take precedence over the settings of the fake.
+func IsolateEnv(t *testing.T) {
+	for _, name := range []string{
+		"YC_SERVICE_ACCOUNT_KEY_FILE", "YC_TOKEN", "YC_TOKEN_COMMAND", "YC_TOKEN_FILE",
+		"YC_OIDC_TOKEN", "YC_OIDC_TOKEN_FILE", "YC_IMPERSONATE_SERVICE_ACCOUNT_ID",
+		"YC_SHARED_CREDENTIALS_FILE", "YC_PROFILE", "YC_ENDPOINT",
+		// Storage client of the SDKv2 provider fails to initialize with custom CA bundle.
+		"AWS_CA_BUNDLE",
+	} {
+		t.Setenv(name, "")
+	}
+}
+
+// SkipWithoutTerraform skips the test, if Terraform CLI, which is required by resource.UnitTest,
+// is not installed and is not configured with TF_ACC_TERRAFORM_PATH or TF_ACC_TERRAFORM_VERSION.
+func SkipWithoutTerraform(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI is not found in PATH")
+	}
+}
+
+// newID generates an ID of the resource with the given three-letter prefix, e.g. "enp" for networks.
+func (s *Server) newID(prefix string) string {
+	s.idCounter++
+	return fmt.Sprintf("%s%017d", prefix, s.idCounter)
+}
+
+type endpointService struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	server *Server
+}
+
+func (e *endpointService) Get(_ context.Context, req *endpoint.GetApiEndpointRequest) (*endpoint.ApiEndpoint, error) {
+	for _, id := range serviceIDs {
+		if id == req.ApiEndpointId {
+			return &endpoint.ApiEndpoint{Id: id, Address: e.server.addr}, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "API endpoint %q not found", req.ApiEndpointId)
+}
+
+func (e *endpointService) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	resp := &endpoint.ListApiEndpointsResponse{}
+	for _, id := range serviceIDs {
+		resp.Endpoints = append(resp.Endpoints, &endpoint.ApiEndpoint{Id: id, Address: e.server.addr})
+	}
+	return resp, nil
+}
+
+// nameFilterRegexp matches the only filter, that is supported by List methods of the fake,
+// it is used by the SDK to resolve resources by name.
+var nameFilterRegexp = regexp.MustCompile(`^\s*name\s*=\s*["']([^"']*)["']\s*$`)
+
+func matchFilter(filter, name string) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+	m := nameFilterRegexp.FindStringSubmatch(filter)
+	if m == nil {
+		return false, status.Errorf(codes.InvalidArgument, "unsupported filter %q", filter)
+	}
+	return m[1] == name, nil
+}
+
+// list returns clones of the items, that belong to the folder and match the filter, ordered by ID.
+func list[T proto.Message](items map[string]T, folderID, filter string, folderOf, nameOf func(T) string) ([]T, error) {
+	ids := make([]string, 0, len(items))
+	for id := range items {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	result := make([]T, 0, len(ids))
+	for _, id := range ids {
+		item := items[id]
+		if folderOf(item) != folderID {
+			continue
+		}
+		ok, err := matchFilter(filter, nameOf(item))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, proto.Clone(item).(T))
+		}
+	}
+	return result, nil
+}
+
+func notFound(kind, id string) error {
+	return status.Errorf(codes.NotFound, "%s %s not found", kind, id)
+}
+
+func (s *Server) checkFolder(folderID string) error {
+	if _, ok := s.folders[folderID]; !ok {
+		return notFound("Folder", folderID)
+	}
+	return nil
+}
+
+// Exists reports whether a resource with the ID exists in the fake, it is used to check destruction of resources.
+func (s *Server) Exists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, network := s.networks[id]
+	_, subnet := s.subnets[id]
+	_, sa := s.serviceAccounts[id]
+	_, disk := s.disks[id]
+	_, folder := s.folders[id]
+	_, dnsZone := s.dnsZones[id]
+	return network || subnet || sa || disk || folder || dnsZone
+}
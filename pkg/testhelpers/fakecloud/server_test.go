@@ -0,0 +1,101 @@
+package fakecloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestSDK(t *testing.T) *ycsdk.SDK {
+	server := New(t)
+
+	sdk, err := ycsdk.Build(context.Background(), ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(Token),
+		Endpoint:    server.Endpoint(),
+		Plaintext:   true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+
+	return sdk
+}
+
+func TestNetworkLifecycle(t *testing.T) {
+	ctx := context.Background()
+	sdk := newTestSDK(t)
+
+	op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+		FolderId: FolderID,
+		Name:     "network",
+		Labels:   map[string]string{"env": "test"},
+	}))
+	require.NoError(t, err)
+	assert.False(t, op.Done(), "operation must be running after create")
+
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	networkID := md.(*vpc.CreateNetworkMetadata).NetworkId
+
+	require.NoError(t, op.Wait(ctx))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	assert.Equal(t, networkID, resp.(*vpc.Network).Id)
+
+	op, err = sdk.WrapOperation(sdk.VPC().Subnet().Create(ctx, &vpc.CreateSubnetRequest{
+		FolderId:     FolderID,
+		Name:         "subnet",
+		NetworkId:    networkID,
+		ZoneId:       Zone,
+		V4CidrBlocks: []string{"10.0.0.0/24"},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	subnets, err := sdk.VPC().Network().ListSubnets(ctx, &vpc.ListNetworkSubnetsRequest{NetworkId: networkID})
+	require.NoError(t, err)
+	require.Len(t, subnets.Subnets, 1)
+
+	_, err = sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "network with subnets must not be deleted")
+
+	op, err = sdk.WrapOperation(sdk.VPC().Network().Update(ctx, &vpc.UpdateNetworkRequest{
+		NetworkId:  networkID,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+		Name:       "renamed",
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	network, err := sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", network.Name)
+	assert.Equal(t, map[string]string{"env": "test"}, network.Labels)
+
+	list, err := sdk.VPC().Network().List(ctx, &vpc.ListNetworksRequest{FolderId: FolderID, Filter: `name = "renamed"`})
+	require.NoError(t, err)
+	require.Len(t, list.Networks, 1)
+
+	op, err = sdk.WrapOperation(sdk.VPC().Subnet().Delete(ctx, &vpc.DeleteSubnetRequest{SubnetId: subnets.Subnets[0].Id}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	op, err = sdk.WrapOperation(sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	_, err = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUnknownFolder(t *testing.T) {
+	sdk := newTestSDK(t)
+
+	_, err := sdk.VPC().Network().Create(context.Background(), &vpc.CreateNetworkRequest{FolderId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,214 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type networkService struct {
+	vpc.UnimplementedNetworkServiceServer
+	server *Server
+}
+
+func (n *networkService) Get(_ context.Context, req *vpc.GetNetworkRequest) (*vpc.Network, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	network, ok := n.server.networks[req.NetworkId]
+	if !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	return proto.Clone(network).(*vpc.Network), nil
+}
+
+func (n *networkService) List(_ context.Context, req *vpc.ListNetworksRequest) (*vpc.ListNetworksResponse, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	networks, err := list(n.server.networks, req.FolderId, req.Filter, (*vpc.Network).GetFolderId, (*vpc.Network).GetName)
+	if err != nil {
+		return nil, err
+	}
+	return &vpc.ListNetworksResponse{Networks: networks}, nil
+}
+
+func (n *networkService) ListSubnets(_ context.Context, req *vpc.ListNetworkSubnetsRequest) (*vpc.ListNetworkSubnetsResponse, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	network, ok := n.server.networks[req.NetworkId]
+	if !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	subnets, err := list(n.server.subnets, network.FolderId, "", (*vpc.Subnet).GetFolderId, (*vpc.Subnet).GetName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.ListNetworkSubnetsResponse{}
+	for _, subnet := range subnets {
+		if subnet.NetworkId == req.NetworkId {
+			resp.Subnets = append(resp.Subnets, subnet)
+		}
+	}
+	return resp, nil
+}
+
+func (n *networkService) Create(_ context.Context, req *vpc.CreateNetworkRequest) (*operation.Operation, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	if err := n.server.checkFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+
+	network := &vpc.Network{
+		Id:                     n.server.newID("enp"),
+		FolderId:               req.FolderId,
+		CreatedAt:              timestamppb.Now(),
+		Name:                   req.Name,
+		Description:            req.Description,
+		Labels:                 req.Labels,
+		DefaultSecurityGroupId: n.server.newID("enp"),
+	}
+	n.server.networks[network.Id] = network
+
+	return n.server.newOperation("Create network", &vpc.CreateNetworkMetadata{NetworkId: network.Id}, network)
+}
+
+func (n *networkService) Update(_ context.Context, req *vpc.UpdateNetworkRequest) (*operation.Operation, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	network, ok := n.server.networks[req.NetworkId]
+	if !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	err := applyUpdateMask(req.UpdateMask, map[string]func(){
+		"name":        func() { network.Name = req.Name },
+		"description": func() { network.Description = req.Description },
+		"labels":      func() { network.Labels = req.Labels },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n.server.newOperation("Update network", &vpc.UpdateNetworkMetadata{NetworkId: network.Id}, network)
+}
+
+func (n *networkService) Delete(_ context.Context, req *vpc.DeleteNetworkRequest) (*operation.Operation, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	if _, ok := n.server.networks[req.NetworkId]; !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	for _, subnet := range n.server.subnets {
+		if subnet.NetworkId == req.NetworkId {
+			return nil, status.Errorf(codes.FailedPrecondition, "Network %s is not empty", req.NetworkId)
+		}
+	}
+	delete(n.server.networks, req.NetworkId)
+
+	return n.server.newOperation("Delete network", &vpc.DeleteNetworkMetadata{NetworkId: req.NetworkId}, nil)
+}
+
+type subnetService struct {
+	vpc.UnimplementedSubnetServiceServer
+	server *Server
+}
+
+func (s *subnetService) Get(_ context.Context, req *vpc.GetSubnetRequest) (*vpc.Subnet, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	subnet, ok := s.server.subnets[req.SubnetId]
+	if !ok {
+		return nil, notFound("Subnet", req.SubnetId)
+	}
+	return proto.Clone(subnet).(*vpc.Subnet), nil
+}
+
+func (s *subnetService) List(_ context.Context, req *vpc.ListSubnetsRequest) (*vpc.ListSubnetsResponse, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	subnets, err := list(s.server.subnets, req.FolderId, req.Filter, (*vpc.Subnet).GetFolderId, (*vpc.Subnet).GetName)
+	if err != nil {
+		return nil, err
+	}
+	return &vpc.ListSubnetsResponse{Subnets: subnets}, nil
+}
+
+func (s *subnetService) Create(_ context.Context, req *vpc.CreateSubnetRequest) (*operation.Operation, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	if err := s.server.checkFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+	if _, ok := s.server.networks[req.NetworkId]; !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	if len(req.V4CidrBlocks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "v4_cidr_blocks is required")
+	}
+
+	subnet := &vpc.Subnet{
+		Id:           s.server.newID("e9b"),
+		FolderId:     req.FolderId,
+		CreatedAt:    timestamppb.Now(),
+		Name:         req.Name,
+		Description:  req.Description,
+		Labels:       req.Labels,
+		NetworkId:    req.NetworkId,
+		ZoneId:       req.ZoneId,
+		V4CidrBlocks: req.V4CidrBlocks,
+		RouteTableId: req.RouteTableId,
+		DhcpOptions:  req.DhcpOptions,
+	}
+	s.server.subnets[subnet.Id] = subnet
+
+	return s.server.newOperation("Create subnet", &vpc.CreateSubnetMetadata{SubnetId: subnet.Id}, subnet)
+}
+
+func (s *subnetService) Update(_ context.Context, req *vpc.UpdateSubnetRequest) (*operation.Operation, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	subnet, ok := s.server.subnets[req.SubnetId]
+	if !ok {
+		return nil, notFound("Subnet", req.SubnetId)
+	}
+	err := applyUpdateMask(req.UpdateMask, map[string]func(){
+		"name":           func() { subnet.Name = req.Name },
+		"description":    func() { subnet.Description = req.Description },
+		"labels":         func() { subnet.Labels = req.Labels },
+		"route_table_id": func() { subnet.RouteTableId = req.RouteTableId },
+		"dhcp_options":   func() { subnet.DhcpOptions = req.DhcpOptions },
+		"v4_cidr_blocks": func() { subnet.V4CidrBlocks = req.V4CidrBlocks },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.server.newOperation("Update subnet", &vpc.UpdateSubnetMetadata{SubnetId: subnet.Id}, subnet)
+}
+
+func (s *subnetService) Delete(_ context.Context, req *vpc.DeleteSubnetRequest) (*operation.Operation, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	if _, ok := s.server.subnets[req.SubnetId]; !ok {
+		return nil, notFound("Subnet", req.SubnetId)
+	}
+	delete(s.server.subnets, req.SubnetId)
+
+	return s.server.newOperation("Delete subnet", &vpc.DeleteSubnetMetadata{SubnetId: req.SubnetId}, nil)
+}
@@ -127,7 +127,7 @@ func regroupTemplatesFiles(templatesDir, tmpDir string, categories categories.Ca
 			return fmt.Errorf("failed to read file %s: %w", path, err)
 		}
 		// We are checking that cats are registered
-		if strings.HasPrefix(filename, "d_") || strings.HasPrefix(filename, "r_") {
+		if strings.HasPrefix(filename, "d_") || strings.HasPrefix(filename, "r_") || strings.HasPrefix(filename, "e_") {
 			cat, err := extractSubcategory(data)
 			if err != nil {
 				log.Printf("Failed to extract subcategory for %s", path)
@@ -146,6 +146,10 @@ func regroupTemplatesFiles(templatesDir, tmpDir string, categories categories.Ca
 			file = filepath.Join(tmpDir, "data-sources", filename[2:])
 		} else if strings.HasPrefix(filename, "r_") {
 			file = filepath.Join(tmpDir, "resources", filename[2:])
+		} else if strings.HasPrefix(filename, "e_") {
+			file = filepath.Join(tmpDir, "ephemeral-resources", filename[2:])
+		} else if strings.HasPrefix(filename, "f_") {
+			file = filepath.Join(tmpDir, "functions", filename[2:])
 		} else if filename == "index.md.tmpl" {
 			file = filepath.Join(tmpDir, filename)
 			err = os.WriteFile(file, data, os.FileMode(0644))
@@ -191,6 +195,18 @@ func main() {
 		return
 	}
 
+	ephemeralResourceDir := filepath.Join(tmpDir, "ephemeral-resources")
+	if err := os.MkdirAll(ephemeralResourceDir, os.ModePerm); err != nil {
+		log.Fatalln("Unable to create temporary dir ephemeral-resources")
+		return
+	}
+
+	functionDir := filepath.Join(tmpDir, "functions")
+	if err := os.MkdirAll(functionDir, os.ModePerm); err != nil {
+		log.Fatalln("Unable to create temporary dir functions")
+		return
+	}
+
 	defer os.RemoveAll(tmpDir)
 
 	var categories_ categories.Categories
@@ -51,6 +51,18 @@ func main() {
 		log.Fatalf("Error while processing resource dir: %s\n", err)
 		return
 	}
+	err = processDirectory(filepath.Join(docsDir, "ephemeral-resources"), "Ephemeral Resources", &toc)
+
+	if err != nil {
+		log.Fatalf("Error while processing ephemeral-resources dir: %s\n", err)
+		return
+	}
+	err = processDirectory(filepath.Join(docsDir, "functions"), "Functions", &toc)
+
+	if err != nil {
+		log.Fatalf("Error while processing functions dir: %s\n", err)
+		return
+	}
 
 	sortTocItems(&toc.Items)
 
@@ -0,0 +1,49 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type bucketARNFunction struct{}
+
+func NewBucketARNFunction() function.Function {
+	return &bucketARNFunction{}
+}
+
+func (f *bucketARNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bucket_arn"
+}
+
+func (f *bucketARNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns ARN of Object Storage bucket.",
+		MarkdownDescription: "Returns ARN of Object Storage bucket in `arn:aws:s3:::BUCKET` format, which is used " +
+			"in bucket policies. ARN of objects can be composed by appending `/KEY` or `/*` to the result.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Name of the bucket.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *bucketARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bucket))
+	if resp.Error != nil {
+		return
+	}
+
+	if bucket == "" || strings.Contains(bucket, "/") {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid bucket name %q", bucket))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, "arn:aws:s3:::"+bucket))
+}
@@ -0,0 +1,101 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type cidrSubnetsForZonesFunction struct{}
+
+func NewCIDRSubnetsForZonesFunction() function.Function {
+	return &cidrSubnetsForZonesFunction{}
+}
+
+func (f *cidrSubnetsForZonesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_zones"
+}
+
+func (f *cidrSubnetsForZonesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits CIDR block into subnets for availability zones.",
+		MarkdownDescription: "Splits CIDR block into consecutive subnets, one for each availability zone, and returns a map " +
+			"from zone to subnet CIDR block. The N-th zone gets the same block as `cidrsubnet(prefix, newbits, N)`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "CIDR block to split, e.g. `10.0.0.0/16`.",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits, by which the prefix of subnets is extended.",
+			},
+			function.ListParameter{
+				Name:                "zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of availability zones, e.g. `[\"ru-central1-a\", \"ru-central1-b\", \"ru-central1-d\"]`.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *cidrSubnetsForZonesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		prefix  string
+		newbits int64
+		zones   []string
+	)
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &prefix, &newbits, &zones))
+	if resp.Error != nil {
+		return
+	}
+
+	base, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid CIDR block %q: %s", prefix, err))
+		return
+	}
+
+	if newbits < 0 || int64(base.Bits())+newbits > int64(base.Addr().BitLen()) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("can not extend prefix %q by %d bits", prefix, newbits))
+		return
+	}
+
+	if capacity := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); big.NewInt(int64(len(zones))).Cmp(capacity) > 0 {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("prefix %q extended by %d bits fits only %s subnets, got %d zones", prefix, newbits, capacity, len(zones)))
+		return
+	}
+
+	subnets := make(map[string]string, len(zones))
+	for i, zone := range zones {
+		if _, ok := subnets[zone]; ok {
+			resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("duplicate zone %q", zone))
+			return
+		}
+		subnets[zone] = cidrSubnet(base, int(newbits), int64(i)).String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, subnets))
+}
+
+// cidrSubnet works the same way as Terraform cidrsubnet function.
+func cidrSubnet(base netip.Prefix, newbits int, num int64) netip.Prefix {
+	base = base.Masked()
+	bits := base.Addr().BitLen()
+	prefixLen := base.Bits() + newbits
+
+	addr := new(big.Int).SetBytes(base.Addr().AsSlice())
+	addr.Or(addr, new(big.Int).Lsh(big.NewInt(num), uint(bits-prefixLen)))
+
+	raw := make([]byte, bits/8)
+	addr.FillBytes(raw)
+	result, _ := netip.AddrFromSlice(raw)
+	return netip.PrefixFrom(result, prefixLen)
+}
@@ -0,0 +1,165 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseIAMMemberFunction(t *testing.T) {
+	cases := []struct {
+		member       string
+		expectedType string
+		expectedID   string
+		expectError  bool
+	}{
+		{member: "serviceAccount:aje1234567890", expectedType: "serviceAccount", expectedID: "aje1234567890"},
+		{member: "userAccount:aje0987654321", expectedType: "userAccount", expectedID: "aje0987654321"},
+		{member: "system:group:organization:bpf123:users", expectedType: "system", expectedID: "group:organization:bpf123:users"},
+		{member: "aje1234567890", expectError: true},
+		{member: "serviceAccount:", expectError: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.member, func(t *testing.T) {
+			result, err := runFunction(t, NewParseIAMMemberFunction(), types.ObjectUnknown(iamMemberAttrTypes), types.StringValue(tc.member))
+			if tc.expectError {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+
+			expected := types.ObjectValueMust(iamMemberAttrTypes, map[string]attr.Value{
+				"type": types.StringValue(tc.expectedType),
+				"id":   types.StringValue(tc.expectedID),
+			})
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func TestBucketARNFunction(t *testing.T) {
+	result, err := runFunction(t, NewBucketARNFunction(), types.StringUnknown(), types.StringValue("my-bucket"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("arn:aws:s3:::my-bucket"), result)
+
+	_, err = runFunction(t, NewBucketARNFunction(), types.StringUnknown(), types.StringValue("my-bucket/key"))
+	assert.NotNil(t, err)
+}
+
+func TestYMQQueueURLToARNFunction(t *testing.T) {
+	result, err := runFunction(t, NewYMQQueueURLToARNFunction(), types.StringUnknown(),
+		types.StringValue("https://message-queue.api.cloud.yandex.net/b1gvlrnlei4l5idm9cbj/dj6000000000bkfp06ps/sample-queue"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("yrn:yc:ymq:ru-central1:b1gvlrnlei4l5idm9cbj:sample-queue"), result)
+
+	_, err = runFunction(t, NewYMQQueueURLToARNFunction(), types.StringUnknown(),
+		types.StringValue("https://message-queue.api.cloud.yandex.net/sample-queue"))
+	assert.NotNil(t, err)
+}
+
+func TestCIDRSubnetsForZonesFunction(t *testing.T) {
+	zones := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("ru-central1-a"),
+		types.StringValue("ru-central1-b"),
+		types.StringValue("ru-central1-d"),
+	})
+
+	cases := []struct {
+		name        string
+		prefix      string
+		newbits     int64
+		zones       types.List
+		expected    map[string]string
+		expectError bool
+	}{
+		{
+			name:    "ipv4",
+			prefix:  "10.0.0.0/16",
+			newbits: 8,
+			zones:   zones,
+			expected: map[string]string{
+				"ru-central1-a": "10.0.0.0/24",
+				"ru-central1-b": "10.0.1.0/24",
+				"ru-central1-d": "10.0.2.0/24",
+			},
+		},
+		{
+			name:    "unaligned prefix",
+			prefix:  "192.168.10.1/24",
+			newbits: 2,
+			zones:   zones,
+			expected: map[string]string{
+				"ru-central1-a": "192.168.10.0/26",
+				"ru-central1-b": "192.168.10.64/26",
+				"ru-central1-d": "192.168.10.128/26",
+			},
+		},
+		{
+			name:    "ipv6",
+			prefix:  "fd00::/48",
+			newbits: 16,
+			zones:   zones,
+			expected: map[string]string{
+				"ru-central1-a": "fd00::/64",
+				"ru-central1-b": "fd00:0:0:1::/64",
+				"ru-central1-d": "fd00:0:0:2::/64",
+			},
+		},
+		{
+			name:        "not enough bits",
+			prefix:      "10.0.0.0/16",
+			newbits:     1,
+			zones:       zones,
+			expectError: true,
+		},
+		{
+			name:        "prefix too long",
+			prefix:      "10.0.0.0/30",
+			newbits:     4,
+			zones:       zones,
+			expectError: true,
+		},
+		{
+			name:    "duplicate zones",
+			prefix:  "10.0.0.0/16",
+			newbits: 8,
+			zones: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("ru-central1-a"),
+				types.StringValue("ru-central1-a"),
+			}),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewCIDRSubnetsForZonesFunction(), types.MapUnknown(types.StringType),
+				types.StringValue(tc.prefix), types.Int64Value(tc.newbits), tc.zones)
+			if tc.expectError {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+
+			expected := make(map[string]attr.Value, len(tc.expected))
+			for k, v := range tc.expected {
+				expected[k] = types.StringValue(v)
+			}
+			assert.Equal(t, types.MapValueMust(types.StringType, expected), result)
+		})
+	}
+}
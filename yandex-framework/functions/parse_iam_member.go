@@ -0,0 +1,68 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
+)
+
+var iamMemberAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+}
+
+type parseIAMMemberFunction struct{}
+
+func NewParseIAMMemberFunction() function.Function {
+	return &parseIAMMemberFunction{}
+}
+
+func (f *parseIAMMemberFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_iam_member"
+}
+
+func (f *parseIAMMemberFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses IAM member into its subject type and ID.",
+		MarkdownDescription: "Parses IAM member in `TYPE:ID` format, e.g. `serviceAccount:aje...`, " +
+			"into an object with `type` and `id` attributes. System groups like `system:group:organization:bpf...:users` " +
+			"are parsed with `system` type.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "member",
+				MarkdownDescription: "IAM member in `TYPE:ID` format.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamMemberAttrTypes,
+		},
+	}
+}
+
+func (f *parseIAMMemberFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var member string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &member))
+	if resp.Error != nil {
+		return
+	}
+
+	subjectType, id, err := accessbinding.ParseMember(member)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(iamMemberAttrTypes, map[string]attr.Value{
+		"type": types.StringValue(subjectType),
+		"id":   types.StringValue(id),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,67 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+type ymqQueueURLToARNFunction struct{}
+
+func NewYMQQueueURLToARNFunction() function.Function {
+	return &ymqQueueURLToARNFunction{}
+}
+
+func (f *ymqQueueURLToARNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ymq_queue_url_to_arn"
+}
+
+func (f *ymqQueueURLToARNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts Message Queue URL to queue ARN.",
+		MarkdownDescription: "Converts Message Queue URL in `https://message-queue.api.cloud.yandex.net/FOLDER_ID/QUEUE_ID/QUEUE_NAME` format " +
+			"to queue ARN in `yrn:yc:ymq:" + common.DefaultRegion + ":FOLDER_ID:QUEUE_NAME` format, which is used by triggers and Event Router.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "URL of the queue, e.g. `id` of `yandex_message_queue` resource.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ymqQueueURLToARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var queueURL string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &queueURL))
+	if resp.Error != nil {
+		return
+	}
+
+	arn, err := ymqQueueURLToARN(queueURL)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, arn))
+}
+
+func ymqQueueURLToARN(queueURL string) (string, error) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse queue URL %q: %s", queueURL, err)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Host == "" || len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return "", fmt.Errorf("expect queue URL to be in https://HOST/FOLDER_ID/QUEUE_ID/QUEUE_NAME format, got %q", queueURL)
+	}
+
+	return fmt.Sprintf("yrn:yc:ymq:%s:%s:%s", common.DefaultRegion, parts[0], parts[2]), nil
+}
@@ -16,6 +16,7 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mitchellh/go-homedir"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/go-sdk/iamkey"
 	"github.com/yandex-cloud/go-sdk/pkg/idempotency"
@@ -24,7 +25,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/credentials"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 )
 
@@ -40,6 +43,13 @@ type State struct {
 	Zone                           types.String `tfsdk:"zone"`
 	Token                          types.String `tfsdk:"token"`
 	ServiceAccountKeyFileOrContent types.String `tfsdk:"service_account_key_file"`
+	TokenCommand                   types.String `tfsdk:"token_command"`
+	TokenFile                      types.String `tfsdk:"token_file"`
+	OIDCToken                      types.String `tfsdk:"oidc_token"`
+	OIDCTokenFile                  types.String `tfsdk:"oidc_token_file"`
+	OIDCServiceAccountID           types.String `tfsdk:"oidc_service_account_id"`
+	OIDCTokenExchangeEndpoint      types.String `tfsdk:"oidc_token_exchange_endpoint"`
+	ImpersonateServiceAccountID    types.String `tfsdk:"impersonate_service_account_id"`
 	Plaintext                      types.Bool   `tfsdk:"plaintext"`
 	Insecure                       types.Bool   `tfsdk:"insecure"`
 	MaxRetries                     types.Int64  `tfsdk:"max_retries"`
@@ -59,6 +69,9 @@ type State struct {
 
 	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
 	Profile               types.String `tfsdk:"profile"`
+
+	// DefaultLabels are merged into labels of every resource, that has them.
+	DefaultLabels types.Map `tfsdk:"default_labels"`
 	//
 	//sharedCredentials *SharedCredentials
 	//defaultS3Client   *s3.S3
@@ -76,6 +89,14 @@ type Config struct {
 func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, sweeper bool) error {
 	ctx = requestid.ContextWithClientTraceID(ctx, uuid.New().String())
 
+	if err := c.applySharedProfile(); err != nil {
+		return err
+	}
+
+	if c.ProviderState.Endpoint.ValueString() == "" {
+		c.ProviderState.Endpoint = types.StringValue(common.DefaultEndpoint)
+	}
+
 	credentials, err := c.Credentials(ctx)
 	if err != nil {
 		return err
@@ -119,27 +140,27 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 		return err
 	}
 
-	c.SDK, err = ycsdk.Build(ctx, *yandexSDKConfig,
+	dialOptions := []grpc.DialOption{
 		grpc.WithUserAgent(c.UserAgent.ValueString()),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
 		grpc.WithUnaryInterceptor(interceptorChain),
-		retryOptions)
+		retryOptions,
+	}
+
+	// All calls are made on behalf of the impersonated service account,
+	// its IAM token is issued and refreshed by the SDK using the base credentials.
+	if saID := c.ProviderState.ImpersonateServiceAccountID.ValueString(); saID != "" {
+		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(ycsdk.WithAuthAsServiceAccount(saID)))
+	}
+
+	c.SDK, err = ycsdk.Build(ctx, *yandexSDKConfig, dialOptions...)
 
 	return err
 }
 
 func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
 	if c.ProviderState.ServiceAccountKeyFileOrContent.ValueString() != "" {
-		contents, _, err := pathOrContents(c.ProviderState.ServiceAccountKeyFileOrContent.ValueString())
-		if err != nil {
-			return nil, fmt.Errorf("Error loading Credentials: %s", err)
-		}
-
-		key, err := iamKeyFromJSONContent(contents)
-		if err != nil {
-			return nil, err
-		}
-		return ycsdk.ServiceAccountKey(key)
+		return ServiceAccountKeyCredentials(c.ProviderState.ServiceAccountKeyFileOrContent.ValueString())
 	}
 
 	if c.ProviderState.Token.ValueString() != "" {
@@ -153,15 +174,94 @@ func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
 		return ycsdk.OAuthToken(c.ProviderState.Token.ValueString()), nil
 	}
 
+	if c.ProviderState.TokenCommand.ValueString() != "" {
+		return credentials.NewTokenCommand(c.ProviderState.TokenCommand.ValueString()), nil
+	}
+
+	if c.ProviderState.TokenFile.ValueString() != "" {
+		return credentials.NewTokenFile(c.ProviderState.TokenFile.ValueString()), nil
+	}
+
+	if c.ProviderState.OIDCToken.ValueString() != "" || c.ProviderState.OIDCTokenFile.ValueString() != "" {
+		return credentials.OIDCTokenExchange(
+			c.ProviderState.OIDCToken.ValueString(),
+			c.ProviderState.OIDCTokenFile.ValueString(),
+			c.ProviderState.OIDCServiceAccountID.ValueString(),
+			c.ProviderState.OIDCTokenExchangeEndpoint.ValueString(),
+		)
+	}
+
 	if sa := ycsdk.InstanceServiceAccount(); checkServiceAccountAvailable(ctx, sa) {
 		return sa, nil
 	}
 
-	return nil, fmt.Errorf("one of 'token' or 'service_account_key_file' should be specified;" +
+	return nil, fmt.Errorf("one of 'token', 'service_account_key_file' or 'oidc_token' should be specified;" +
 		" if you are inside compute instance, you can attach service account to it in order to " +
 		"authenticate via instance service account")
 }
 
+// applySharedProfile fills settings, which are not specified explicitly in the provider configuration
+// or environment variables, from yc CLI profile. Shared credentials files of other formats are
+// only used by storage resources of SDKv2 provider.
+func (c *Config) applySharedProfile() error {
+	if c.ProviderState.SharedCredentialsFile.ValueString() == "" {
+		return nil
+	}
+
+	profile, err := credentials.ReadYCProfile(c.ProviderState.SharedCredentialsFile.ValueString(), c.ProviderState.Profile.ValueString())
+	if err != nil {
+		return err
+	}
+	if profile == nil {
+		return nil
+	}
+
+	state := &c.ProviderState
+	setIfEmpty := func(field *types.String, value string) {
+		if field.ValueString() == "" {
+			*field = types.StringValue(value)
+		}
+	}
+	setIfEmpty(&state.Endpoint, profile.Endpoint)
+	setIfEmpty(&state.CloudID, profile.CloudID)
+	setIfEmpty(&state.FolderID, profile.FolderID)
+	setIfEmpty(&state.Zone, profile.Zone)
+
+	for _, field := range []types.String{state.Token, state.ServiceAccountKeyFileOrContent, state.TokenCommand, state.TokenFile, state.OIDCToken, state.OIDCTokenFile} {
+		if field.ValueString() != "" {
+			return nil
+		}
+	}
+	state.Token = types.StringValue(profile.Token)
+	state.ServiceAccountKeyFileOrContent = types.StringValue(profile.ServiceAccountKey)
+	state.TokenCommand = types.StringValue(profile.TokenCommand)
+	return nil
+}
+
+// CreateIAMToken issues IAM token for the provider credentials,
+// or for the impersonated service account if it is configured.
+func (c *Config) CreateIAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	if saID := c.ProviderState.ImpersonateServiceAccountID.ValueString(); saID != "" {
+		return c.SDK.CreateIAMTokenForServiceAccount(ctx, saID)
+	}
+	return c.SDK.CreateIAMToken(ctx)
+}
+
+// ServiceAccountKeyCredentials builds credentials from either a path to or the contents of
+// a service account key file in JSON format.
+func ServiceAccountKeyCredentials(keyFileOrContent string) (ycsdk.Credentials, error) {
+	contents, _, err := pathOrContents(keyFileOrContent)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading Credentials: %s", err)
+	}
+
+	key, err := iamKeyFromJSONContent(contents)
+	if err != nil {
+		return nil, err
+	}
+	return ycsdk.ServiceAccountKey(key)
+}
+
 func iamKeyFromJSONContent(content string) (*iamkey.Key, error) {
 	key := &iamkey.Key{}
 	err := json.Unmarshal([]byte(content), key)
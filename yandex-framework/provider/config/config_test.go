@@ -0,0 +1,63 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+func TestInitAndValidateWithFakeEndpoint(t *testing.T) {
+	server := fakecloud.New(t)
+
+	c := &Config{
+		ProviderState: State{
+			Endpoint:   types.StringValue(server.Endpoint()),
+			Plaintext:  types.BoolValue(true),
+			Token:      types.StringValue(fakecloud.Token),
+			MaxRetries: types.Int64Value(1),
+		},
+	}
+	require.NoError(t, c.InitAndValidate(context.Background(), "1.10.0", false))
+
+	folder, err := c.SDK.ResourceManager().Folder().Get(context.Background(), &resourcemanager.GetFolderRequest{
+		FolderId: fakecloud.FolderID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fakecloud.CloudID, folder.CloudId)
+
+	token, err := c.CreateIAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fakecloud.Token, token.IamToken)
+}
+
+func TestInitAndValidateWithImpersonation(t *testing.T) {
+	server := fakecloud.New(t)
+	saID := server.AddServiceAccount("impersonated")
+
+	c := &Config{
+		ProviderState: State{
+			Endpoint:                    types.StringValue(server.Endpoint()),
+			Plaintext:                   types.BoolValue(true),
+			Token:                       types.StringValue(fakecloud.Token),
+			ImpersonateServiceAccountID: types.StringValue(saID),
+			MaxRetries:                  types.Int64Value(1),
+		},
+	}
+	require.NoError(t, c.InitAndValidate(context.Background(), "1.10.0", false))
+
+	_, err := c.SDK.ResourceManager().Folder().Get(context.Background(), &resourcemanager.GetFolderRequest{
+		FolderId: fakecloud.FolderID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fakecloud.ServiceAccountToken(saID), server.RequestToken(resourcemanager.FolderService_Get_FullMethodName))
+
+	token, err := c.CreateIAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fakecloud.ServiceAccountToken(saID), token.IamToken)
+}
@@ -0,0 +1,511 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const (
+	labelsFieldName          = "labels"
+	effectiveLabelsFieldName = "effective_labels"
+)
+
+// withDefaultLabels merges provider `default_labels` into `labels` of every resource, that has them.
+// Resources send merged labels to the API, but keep only configured labels in `labels`,
+// so default labels are not shown in the plan as drift. Merged labels are planned in the computed
+// `effective_labels` attribute, which is added to the schema of the resource, so a change of default labels
+// is shown in the plan and is applied to existing resources. Wrapped resources are not aware of the attribute,
+// it is removed from the values passed to them and is added to the values they return.
+func withDefaultLabels(config *provider_config.Config, resources []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(resources))
+	for _, newResource := range resources {
+		if !hasLabels(newResource()) {
+			wrapped = append(wrapped, newResource)
+			continue
+		}
+
+		newResource := newResource
+		wrapped = append(wrapped, func() resource.Resource {
+			return &defaultLabelsResource{Resource: newResource(), config: config}
+		})
+	}
+	return wrapped
+}
+
+func hasLabels(r resource.Resource) bool {
+	resp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if _, ok := resp.Schema.Attributes[effectiveLabelsFieldName]; ok {
+		return false
+	}
+	labels, ok := resp.Schema.Attributes[labelsFieldName].(schema.MapAttribute)
+	return ok && labels.ElementType == types.StringType
+}
+
+type defaultLabelsResource struct {
+	resource.Resource
+	config *provider_config.Config
+}
+
+var (
+	_ resource.ResourceWithConfigure        = &defaultLabelsResource{}
+	_ resource.ResourceWithImportState      = &defaultLabelsResource{}
+	_ resource.ResourceWithModifyPlan       = &defaultLabelsResource{}
+	_ resource.ResourceWithUpgradeState     = &defaultLabelsResource{}
+	_ resource.ResourceWithValidateConfig   = &defaultLabelsResource{}
+	_ resource.ResourceWithConfigValidators = &defaultLabelsResource{}
+)
+
+func (r *defaultLabelsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.Resource.Schema(ctx, req, resp)
+
+	// Attributes are copied, since the wrapped resource may return the same map on every call.
+	attributes := make(map[string]schema.Attribute, len(resp.Schema.Attributes)+1)
+	for k, v := range resp.Schema.Attributes {
+		attributes[k] = v
+	}
+	attributes[effectiveLabelsFieldName] = schema.MapAttribute{
+		MarkdownDescription: common.ResourceDescriptions[effectiveLabelsFieldName],
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	resp.Schema.Attributes = attributes
+}
+
+// innerSchema returns the schema of the wrapped resource.
+func (r *defaultLabelsResource) innerSchema(ctx context.Context) schema.Schema {
+	resp := resource.SchemaResponse{}
+	r.Resource.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// innerValue removes effective labels from the value, so it conforms to the schema of the wrapped resource.
+func innerValue(ctx context.Context, v tftypes.Value, inner schema.Schema, diags *diag.Diagnostics) tftypes.Value {
+	innerType := inner.Type().TerraformType(ctx)
+	if v.IsNull() {
+		return tftypes.NewValue(innerType, nil)
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(innerType, tftypes.UnknownValue)
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := v.As(&attributes); err != nil {
+		diags.AddError("Unable to Convert Resource Value", fmt.Sprintf("Failed to remove %s from the value: %s", effectiveLabelsFieldName, err))
+		return tftypes.NewValue(innerType, nil)
+	}
+	delete(attributes, effectiveLabelsFieldName)
+	return tftypes.NewValue(innerType, attributes)
+}
+
+// outerValue adds effective labels to the value of the wrapped resource.
+func outerValue(ctx context.Context, v tftypes.Value, outerType tftypes.Type, effectiveLabels types.Map, diags *diag.Diagnostics) tftypes.Value {
+	if v.IsNull() {
+		return tftypes.NewValue(outerType, nil)
+	}
+
+	labels, err := effectiveLabels.ToTerraformValue(ctx)
+	attributes := map[string]tftypes.Value{}
+	if err == nil {
+		err = v.As(&attributes)
+	}
+	if err != nil {
+		diags.AddError("Unable to Convert Resource Value", fmt.Sprintf("Failed to add %s to the value: %s", effectiveLabelsFieldName, err))
+		return tftypes.NewValue(outerType, nil)
+	}
+	attributes[effectiveLabelsFieldName] = labels
+	return tftypes.NewValue(outerType, attributes)
+}
+
+func (r *defaultLabelsResource) innerConfig(ctx context.Context, config tfsdk.Config, inner schema.Schema, diags *diag.Diagnostics) tfsdk.Config {
+	return tfsdk.Config{Schema: inner, Raw: innerValue(ctx, config.Raw, inner, diags)}
+}
+
+func (r *defaultLabelsResource) innerPlan(ctx context.Context, plan tfsdk.Plan, inner schema.Schema, diags *diag.Diagnostics) tfsdk.Plan {
+	return tfsdk.Plan{Schema: inner, Raw: innerValue(ctx, plan.Raw, inner, diags)}
+}
+
+func (r *defaultLabelsResource) innerState(ctx context.Context, state tfsdk.State, inner schema.Schema, diags *diag.Diagnostics) tfsdk.State {
+	return tfsdk.State{Schema: inner, Raw: innerValue(ctx, state.Raw, inner, diags)}
+}
+
+// getEffectiveLabels returns effective labels of the plan or the state.
+func getEffectiveLabels(ctx context.Context, data interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, diags *diag.Diagnostics) types.Map {
+	var labels types.Map
+	diags.Append(data.GetAttribute(ctx, path.Root(effectiveLabelsFieldName), &labels)...)
+	return labels
+}
+
+// appliedEffectiveLabels returns labels of the applied state as effective ones,
+// if the labels were not known in the plan.
+func appliedEffectiveLabels(ctx context.Context, effective types.Map, state tfsdk.State, diags *diag.Diagnostics) types.Map {
+	if !effective.IsUnknown() || state.Raw.IsNull() || diags.HasError() {
+		return effective
+	}
+
+	var labels types.Map
+	diags.Append(state.GetAttribute(ctx, path.Root(labelsFieldName), &labels)...)
+	if labels.IsUnknown() || diags.HasError() {
+		return types.MapNull(types.StringType)
+	}
+	effective, d := types.MapValue(types.StringType, labels.Elements())
+	diags.Append(d...)
+	return effective
+}
+
+func (r *defaultLabelsResource) defaultLabels(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	labels := map[string]string{}
+	if r.config == nil || r.config.ProviderState.DefaultLabels.IsNull() || r.config.ProviderState.DefaultLabels.IsUnknown() {
+		return labels, nil
+	}
+	diags := r.config.ProviderState.DefaultLabels.ElementsAs(ctx, &labels, false)
+	return labels, diags
+}
+
+// mergedLabels returns labels merged with the default ones, they are unknown, if any of them is unknown.
+// Merged labels are never null, as effective labels read from a resource without labels.
+func (r *defaultLabelsResource) mergedLabels(ctx context.Context, labels types.Map) (types.Map, diag.Diagnostics) {
+	if labels.IsUnknown() || (r.config != nil && r.config.ProviderState.DefaultLabels.IsUnknown()) {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	merged, diags := r.defaultLabels(ctx)
+	if diags.HasError() {
+		return types.MapUnknown(types.StringType), diags
+	}
+	if !labels.IsNull() {
+		// ElementsAs replaces the target map, so configured labels are decoded separately.
+		configured := map[string]string{}
+		diags.Append(labels.ElementsAs(ctx, &configured, false)...)
+		if diags.HasError() {
+			return types.MapUnknown(types.StringType), diags
+		}
+		for k, v := range configured {
+			merged[k] = v
+		}
+	}
+
+	result, d := types.MapValueFrom(ctx, types.StringType, merged)
+	diags.Append(d...)
+	return result, diags
+}
+
+func (r *defaultLabelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	inner := r.innerSchema(ctx)
+	innerReq := req
+	innerReq.Config = r.innerConfig(ctx, req.Config, inner, &resp.Diagnostics)
+	innerReq.Plan = r.innerPlan(ctx, req.Plan, inner, &resp.Diagnostics)
+	innerResp := *resp
+	innerResp.State = r.innerState(ctx, resp.State, inner, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, effective, diags := r.mergePlannedLabels(ctx, &innerReq.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	innerResp.Diagnostics = resp.Diagnostics
+	r.Resource.Create(ctx, innerReq, &innerResp)
+	r.restorePlannedLabels(ctx, planned, &innerResp.State, &innerResp.Diagnostics)
+	effective = appliedEffectiveLabels(ctx, effective, innerResp.State, &innerResp.Diagnostics)
+
+	outer := resp.State.Schema
+	*resp = innerResp
+	resp.State = tfsdk.State{Schema: outer, Raw: outerValue(ctx, innerResp.State.Raw, outer.Type().TerraformType(ctx), effective, &resp.Diagnostics)}
+}
+
+func (r *defaultLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	inner := r.innerSchema(ctx)
+	innerReq := req
+	innerReq.Config = r.innerConfig(ctx, req.Config, inner, &resp.Diagnostics)
+	innerReq.Plan = r.innerPlan(ctx, req.Plan, inner, &resp.Diagnostics)
+	innerReq.State = r.innerState(ctx, req.State, inner, &resp.Diagnostics)
+	innerResp := *resp
+	innerResp.State = r.innerState(ctx, resp.State, inner, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, effective, diags := r.mergePlannedLabels(ctx, &innerReq.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	innerResp.Diagnostics = resp.Diagnostics
+	r.Resource.Update(ctx, innerReq, &innerResp)
+	r.restorePlannedLabels(ctx, planned, &innerResp.State, &innerResp.Diagnostics)
+	effective = appliedEffectiveLabels(ctx, effective, innerResp.State, &innerResp.Diagnostics)
+
+	outer := resp.State.Schema
+	*resp = innerResp
+	resp.State = tfsdk.State{Schema: outer, Raw: outerValue(ctx, innerResp.State.Raw, outer.Type().TerraformType(ctx), effective, &resp.Diagnostics)}
+}
+
+func (r *defaultLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	inner := r.innerSchema(ctx)
+	innerReq := req
+	innerReq.State = r.innerState(ctx, req.State, inner, &resp.Diagnostics)
+	innerResp := *resp
+	innerResp.State = r.innerState(ctx, resp.State, inner, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Read(ctx, innerReq, &innerResp)
+	effective := getEffectiveLabels(ctx, req.State, &innerResp.Diagnostics)
+	if !innerResp.Diagnostics.HasError() && !innerResp.State.Raw.IsNull() {
+		effective = r.stripDefaultLabels(ctx, innerReq.State, &innerResp.State, &innerResp.Diagnostics)
+	}
+
+	outer := resp.State.Schema
+	*resp = innerResp
+	resp.State = tfsdk.State{Schema: outer, Raw: outerValue(ctx, innerResp.State.Raw, outer.Type().TerraformType(ctx), effective, &resp.Diagnostics)}
+}
+
+// stripDefaultLabels removes labels, that came from provider defaults and were not in the prior state,
+// from the state and returns all labels of the resource as effective ones.
+func (r *defaultLabelsResource) stripDefaultLabels(ctx context.Context, prior tfsdk.State, state *tfsdk.State, diags *diag.Diagnostics) types.Map {
+	var priorLabels, current types.Map
+	diags.Append(prior.GetAttribute(ctx, path.Root(labelsFieldName), &priorLabels)...)
+	diags.Append(state.GetAttribute(ctx, path.Root(labelsFieldName), &current)...)
+	if diags.HasError() || current.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+
+	effective, d := types.MapValue(types.StringType, current.Elements())
+	diags.Append(d...)
+
+	defaultLabels, d := r.defaultLabels(ctx)
+	diags.Append(d...)
+	if len(defaultLabels) == 0 || current.IsNull() || diags.HasError() {
+		return effective
+	}
+
+	// Default labels are tracked in effective labels, so their values, that differ from the defaults,
+	// are shown in the plan as an update of effective labels instead of removal of labels.
+	priorElements := priorLabels.Elements()
+	elements := make(map[string]attr.Value, len(current.Elements()))
+	for k, v := range current.Elements() {
+		_, tracked := priorElements[k]
+		if _, isDefault := defaultLabels[k]; isDefault && !tracked {
+			continue
+		}
+		elements[k] = v
+	}
+
+	labels := types.MapNull(types.StringType)
+	if len(elements) != 0 || !priorLabels.IsNull() {
+		labels, d = types.MapValue(types.StringType, elements)
+		diags.Append(d...)
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root(labelsFieldName), labels)...)
+	return effective
+}
+
+// mergePlannedLabels replaces planned labels with the labels merged with the default ones
+// and returns the original planned labels and the merged ones.
+func (r *defaultLabelsResource) mergePlannedLabels(ctx context.Context, plan *tfsdk.Plan) (types.Map, types.Map, diag.Diagnostics) {
+	var planned types.Map
+	diags := plan.GetAttribute(ctx, path.Root(labelsFieldName), &planned)
+	if diags.HasError() {
+		return planned, planned, diags
+	}
+
+	merged, d := r.mergedLabels(ctx, planned)
+	diags.Append(d...)
+	if merged.IsUnknown() || diags.HasError() {
+		return planned, merged, diags
+	}
+
+	defaultLabels, d := r.defaultLabels(ctx)
+	diags.Append(d...)
+	if len(defaultLabels) != 0 {
+		diags.Append(plan.SetAttribute(ctx, path.Root(labelsFieldName), merged)...)
+	}
+	return planned, merged, diags
+}
+
+// restorePlannedLabels keeps labels in the state consistent with the plan.
+func (r *defaultLabelsResource) restorePlannedLabels(ctx context.Context, planned types.Map, state *tfsdk.State, diags *diag.Diagnostics) {
+	if diags.HasError() || state.Raw.IsNull() || planned.IsUnknown() {
+		return
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root(labelsFieldName), planned)...)
+}
+
+func (r *defaultLabelsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	inner := r.innerSchema(ctx)
+	innerReq := req
+	innerReq.State = r.innerState(ctx, req.State, inner, &resp.Diagnostics)
+	innerResp := *resp
+	innerResp.State = r.innerState(ctx, resp.State, inner, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Delete(ctx, innerReq, &innerResp)
+	effective := getEffectiveLabels(ctx, req.State, &innerResp.Diagnostics)
+
+	outer := resp.State.Schema
+	*resp = innerResp
+	resp.State = tfsdk.State{Schema: outer, Raw: outerValue(ctx, innerResp.State.Raw, outer.Type().TerraformType(ctx), effective, &resp.Diagnostics)}
+}
+
+func (r *defaultLabelsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if rc, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		rc.Configure(ctx, req, resp)
+	}
+}
+
+func (r *defaultLabelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ri, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	innerResp := *resp
+	innerResp.State = r.innerState(ctx, resp.State, r.innerSchema(ctx), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ri.ImportState(ctx, req, &innerResp)
+
+	// Effective labels are filled by Read, that follows the import.
+	outer := resp.State.Schema
+	*resp = innerResp
+	resp.State = tfsdk.State{Schema: outer, Raw: outerValue(ctx, innerResp.State.Raw, outer.Type().TerraformType(ctx), types.MapNull(types.StringType), &resp.Diagnostics)}
+}
+
+// ModifyPlan plans effective labels as planned labels merged with the default ones,
+// so a change of default labels is shown in the plan as an update of the resource.
+func (r *defaultLabelsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if rm, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		r.modifyInnerPlan(ctx, rm, req, resp)
+	}
+	if resp.Diagnostics.HasError() || resp.Plan.Raw.IsNull() {
+		return
+	}
+
+	var labels types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(labelsFieldName), &labels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	effective, diags := r.mergedLabels(ctx, labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(effectiveLabelsFieldName), effective)...)
+}
+
+func (r *defaultLabelsResource) modifyInnerPlan(ctx context.Context, rm resource.ResourceWithModifyPlan, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	inner := r.innerSchema(ctx)
+	innerReq := req
+	innerReq.Config = r.innerConfig(ctx, req.Config, inner, &resp.Diagnostics)
+	innerReq.Plan = r.innerPlan(ctx, req.Plan, inner, &resp.Diagnostics)
+	innerReq.State = r.innerState(ctx, req.State, inner, &resp.Diagnostics)
+	innerResp := *resp
+	innerResp.Plan = r.innerPlan(ctx, resp.Plan, inner, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rm.ModifyPlan(ctx, innerReq, &innerResp)
+	effective := getEffectiveLabels(ctx, resp.Plan, &innerResp.Diagnostics)
+
+	outer := resp.Plan.Schema
+	*resp = innerResp
+	resp.Plan = tfsdk.Plan{Schema: outer, Raw: outerValue(ctx, innerResp.Plan.Raw, outer.Type().TerraformType(ctx), effective, &resp.Diagnostics)}
+}
+
+// UpgradeState wraps state upgraders of the resource, so they produce the state without effective labels.
+// Effective labels are filled by Read, that follows the upgrade.
+func (r *defaultLabelsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	ru, ok := r.Resource.(resource.ResourceWithUpgradeState)
+	if !ok {
+		return nil
+	}
+
+	upgraders := ru.UpgradeState(ctx)
+	for version, upgrader := range upgraders {
+		upgrade := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			innerResp := *resp
+			innerResp.State = r.innerState(ctx, resp.State, r.innerSchema(ctx), &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			upgrade(ctx, req, &innerResp)
+
+			outer := resp.State.Schema
+			*resp = innerResp
+			resp.State = tfsdk.State{Schema: outer, Raw: outerValue(ctx, innerResp.State.Raw, outer.Type().TerraformType(ctx), types.MapNull(types.StringType), &resp.Diagnostics)}
+		}
+		upgraders[version] = upgrader
+	}
+	return upgraders
+}
+
+func (r *defaultLabelsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if rv, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		req.Config = r.innerConfig(ctx, req.Config, r.innerSchema(ctx), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rv.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (r *defaultLabelsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	rv, ok := r.Resource.(resource.ResourceWithConfigValidators)
+	if !ok {
+		return nil
+	}
+
+	validators := rv.ConfigValidators(ctx)
+	wrapped := make([]resource.ConfigValidator, 0, len(validators))
+	for _, v := range validators {
+		wrapped = append(wrapped, &defaultLabelsConfigValidator{ConfigValidator: v, resource: r})
+	}
+	return wrapped
+}
+
+// defaultLabelsConfigValidator passes the configuration without effective labels to the validator of the resource.
+type defaultLabelsConfigValidator struct {
+	resource.ConfigValidator
+	resource *defaultLabelsResource
+}
+
+func (v *defaultLabelsConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	req.Config = v.resource.innerConfig(ctx, req.Config, v.resource.innerSchema(ctx), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	v.ConfigValidator.ValidateResource(ctx, req, resp)
+}
@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// fakeLabelsModel has no effective labels, so values with them can't be decoded into it.
+type fakeLabelsModel struct {
+	ID     types.String `tfsdk:"id"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+// fakeLabelsResource stores labels sent by Create and Update in remote and reads them back as is.
+type fakeLabelsResource struct {
+	remote map[string]string
+}
+
+func (r *fakeLabelsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "yandex_fake"
+}
+
+func (r *fakeLabelsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = testLabelsSchema
+}
+
+func (r *fakeLabelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model fakeLabelsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	r.remote = mapLabels(ctx, model.Labels)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *fakeLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model fakeLabelsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	model.Labels = types.MapNull(types.StringType)
+	if r.remote != nil {
+		labels, diags := types.MapValueFrom(ctx, types.StringType, r.remote)
+		resp.Diagnostics.Append(diags...)
+		model.Labels = labels
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *fakeLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model fakeLabelsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	r.remote = mapLabels(ctx, model.Labels)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *fakeLabelsResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *fakeLabelsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var model fakeLabelsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, model)...)
+}
+
+var testLabelsSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{Computed: true},
+		labelsFieldName: schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	},
+}
+
+func mapLabels(ctx context.Context, labels types.Map) map[string]string {
+	if labels.IsNull() {
+		return nil
+	}
+	result := map[string]string{}
+	labels.ElementsAs(ctx, &result, false)
+	return result
+}
+
+func testLabelsMapValue(labels map[string]string) tftypes.Value {
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	if labels == nil {
+		return tftypes.NewValue(mapType, nil)
+	}
+	elements := make(map[string]tftypes.Value, len(labels))
+	for k, v := range labels {
+		elements[k] = tftypes.NewValue(tftypes.String, v)
+	}
+	return tftypes.NewValue(mapType, elements)
+}
+
+var unknownLabels = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+// testOuterSchema returns the schema of the wrapped fake resource.
+func testOuterSchema() schema.Schema {
+	resp := resource.SchemaResponse{}
+	(&defaultLabelsResource{Resource: &fakeLabelsResource{}}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// testLabelsValue builds the raw value of the wrapped resource.
+func testLabelsValue(labels, effective tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(testOuterSchema().Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                     tftypes.NewValue(tftypes.String, "id"),
+		labelsFieldName:          labels,
+		effectiveLabelsFieldName: effective,
+	})
+}
+
+func testPlan(labels, effective tftypes.Value) tfsdk.Plan {
+	return tfsdk.Plan{Schema: testOuterSchema(), Raw: testLabelsValue(labels, effective)}
+}
+
+func testState(labels, effective tftypes.Value) tfsdk.State {
+	return tfsdk.State{Schema: testOuterSchema(), Raw: testLabelsValue(labels, effective)}
+}
+
+func emptyTestState() tfsdk.State {
+	return tfsdk.State{Schema: testOuterSchema(), Raw: tftypes.NewValue(testOuterSchema().Type().TerraformType(context.Background()), nil)}
+}
+
+// getTestLabels returns the map attribute of the plan or the state, null labels are nil.
+func getTestLabels(ctx context.Context, data interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, name string) map[string]string {
+	var labels types.Map
+	data.GetAttribute(ctx, path.Root(name), &labels)
+	return mapLabels(ctx, labels)
+}
+
+func newTestDefaultLabelsResource(t *testing.T, defaultLabels map[string]string) (*defaultLabelsResource, *fakeLabelsResource) {
+	t.Helper()
+
+	config := &provider_config.Config{}
+	config.ProviderState.DefaultLabels = types.MapNull(types.StringType)
+	if defaultLabels != nil {
+		labels, diags := types.MapValueFrom(context.Background(), types.StringType, defaultLabels)
+		require.False(t, diags.HasError(), diags)
+		config.ProviderState.DefaultLabels = labels
+	}
+
+	inner := &fakeLabelsResource{}
+	return &defaultLabelsResource{Resource: inner, config: config}, inner
+}
+
+func TestDefaultLabelsResource_Schema(t *testing.T) {
+	s := testOuterSchema()
+
+	effective, ok := s.Attributes[effectiveLabelsFieldName].(schema.MapAttribute)
+	require.True(t, ok)
+	assert.True(t, effective.Computed)
+	assert.False(t, effective.Optional)
+	assert.NotContains(t, testLabelsSchema.Attributes, effectiveLabelsFieldName, "schema of the wrapped resource must not be changed")
+	assert.False(t, hasLabels(&defaultLabelsResource{Resource: &fakeLabelsResource{}}), "resource must not be wrapped twice")
+}
+
+func TestDefaultLabelsResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	defaultLabels := map[string]string{"team": "platform", "env": "prod"}
+
+	cases := []struct {
+		name      string
+		labels    tftypes.Value
+		effective map[string]string
+		unknown   bool
+	}{
+		{
+			name:      "labels take precedence over defaults",
+			labels:    testLabelsMapValue(map[string]string{"env": "testing", "app": "web"}),
+			effective: map[string]string{"team": "platform", "env": "testing", "app": "web"},
+		},
+		{
+			name:      "null labels",
+			labels:    testLabelsMapValue(nil),
+			effective: map[string]string{"team": "platform", "env": "prod"},
+		},
+		{
+			name:    "unknown labels",
+			labels:  unknownLabels,
+			unknown: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, _ := newTestDefaultLabelsResource(t, defaultLabels)
+
+			// Effective labels of the prior state are outdated, e.g. default labels were changed.
+			state := testState(tc.labels, testLabelsMapValue(map[string]string{"team": "devops"}))
+			plan := testPlan(tc.labels, unknownLabels)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: tfsdk.Config(plan), State: state, Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var effective types.Map
+			resp.Plan.GetAttribute(ctx, path.Root(effectiveLabelsFieldName), &effective)
+			assert.Equal(t, tc.unknown, effective.IsUnknown())
+			if !tc.unknown {
+				assert.Equal(t, tc.effective, mapLabels(ctx, effective))
+			}
+		})
+	}
+}
+
+func TestDefaultLabelsResource_ModifyPlanDestroy(t *testing.T) {
+	ctx := context.Background()
+	r, _ := newTestDefaultLabelsResource(t, map[string]string{"team": "platform"})
+
+	plan := tfsdk.Plan{Schema: testOuterSchema(), Raw: emptyTestState().Raw}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config(plan),
+		State:  testState(testLabelsMapValue(nil), testLabelsMapValue(map[string]string{"team": "platform"})),
+		Plan:   plan,
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, resp.Plan.Raw.IsNull())
+}
+
+func TestDefaultLabelsResource_Create(t *testing.T) {
+	ctx := context.Background()
+	defaultLabels := map[string]string{"team": "platform", "env": "prod"}
+
+	cases := []struct {
+		name   string
+		labels map[string]string
+		remote map[string]string
+	}{
+		{
+			name:   "labels take precedence over defaults",
+			labels: map[string]string{"env": "testing", "app": "web"},
+			remote: map[string]string{"team": "platform", "env": "testing", "app": "web"},
+		},
+		{
+			name:   "null labels",
+			remote: map[string]string{"team": "platform", "env": "prod"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, inner := newTestDefaultLabelsResource(t, defaultLabels)
+
+			plan := testPlan(testLabelsMapValue(tc.labels), testLabelsMapValue(tc.remote))
+			resp := &resource.CreateResponse{State: emptyTestState()}
+			r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config(plan), Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, tc.remote, inner.remote)
+			assert.Equal(t, tc.labels, getTestLabels(ctx, resp.State, labelsFieldName))
+			assert.Equal(t, tc.remote, getTestLabels(ctx, resp.State, effectiveLabelsFieldName))
+		})
+	}
+}
+
+func TestDefaultLabelsResource_Update(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name     string
+		labels   map[string]string
+		prior    map[string]string
+		expected map[string]string
+	}{
+		{
+			name:     "configured labels changed",
+			labels:   map[string]string{"app": "api"},
+			prior:    map[string]string{"team": "platform", "env": "prod", "app": "web"},
+			expected: map[string]string{"team": "platform", "env": "prod", "app": "api"},
+		},
+		{
+			name:     "default labels changed",
+			labels:   map[string]string{"app": "web"},
+			prior:    map[string]string{"team": "devops", "app": "web"},
+			expected: map[string]string{"team": "platform", "env": "prod", "app": "web"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, inner := newTestDefaultLabelsResource(t, map[string]string{"team": "platform", "env": "prod"})
+			inner.remote = tc.prior
+
+			state := testState(testLabelsMapValue(map[string]string{"app": "web"}), testLabelsMapValue(tc.prior))
+			plan := testPlan(testLabelsMapValue(tc.labels), testLabelsMapValue(tc.expected))
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Config: tfsdk.Config(plan), Plan: plan, State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, tc.expected, inner.remote)
+			assert.Equal(t, tc.labels, getTestLabels(ctx, resp.State, labelsFieldName))
+			assert.Equal(t, tc.expected, getTestLabels(ctx, resp.State, effectiveLabelsFieldName))
+		})
+	}
+}
+
+func TestDefaultLabelsResource_Read(t *testing.T) {
+	ctx := context.Background()
+	defaultLabels := map[string]string{"team": "platform", "env": "prod"}
+
+	cases := []struct {
+		name     string
+		prior    map[string]string
+		remote   map[string]string
+		expected map[string]string
+	}{
+		{
+			name:     "default labels are stripped",
+			prior:    map[string]string{"app": "web"},
+			remote:   map[string]string{"team": "platform", "env": "prod", "app": "web"},
+			expected: map[string]string{"app": "web"},
+		},
+		{
+			name:     "configured labels equal to defaults are kept",
+			prior:    map[string]string{"env": "prod"},
+			remote:   map[string]string{"team": "platform", "env": "prod"},
+			expected: map[string]string{"env": "prod"},
+		},
+		{
+			// Changed values of default labels are shown in the plan as an update of effective labels.
+			name:     "changed default labels are stripped",
+			prior:    map[string]string{"app": "web"},
+			remote:   map[string]string{"team": "devops", "env": "prod", "app": "web", "owner": "me"},
+			expected: map[string]string{"app": "web", "owner": "me"},
+		},
+		{
+			name:     "missing default labels",
+			prior:    map[string]string{"app": "web"},
+			remote:   map[string]string{"app": "web"},
+			expected: map[string]string{"app": "web"},
+		},
+		{
+			name:   "null labels stay null",
+			remote: map[string]string{"team": "platform", "env": "prod"},
+		},
+		{
+			name:     "empty labels stay empty",
+			prior:    map[string]string{},
+			remote:   map[string]string{"team": "platform", "env": "prod"},
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, inner := newTestDefaultLabelsResource(t, defaultLabels)
+			inner.remote = tc.remote
+
+			state := testState(testLabelsMapValue(tc.prior), testLabelsMapValue(nil))
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, tc.expected, getTestLabels(ctx, resp.State, labelsFieldName))
+			assert.Equal(t, tc.remote, getTestLabels(ctx, resp.State, effectiveLabelsFieldName))
+		})
+	}
+}
+
+func TestDefaultLabelsResource_withoutDefaults(t *testing.T) {
+	ctx := context.Background()
+	r, inner := newTestDefaultLabelsResource(t, nil)
+
+	labels := testLabelsMapValue(map[string]string{"app": "web"})
+	plan := testPlan(labels, labels)
+	createResp := &resource.CreateResponse{State: emptyTestState()}
+	r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config(plan), Plan: plan}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, map[string]string{"app": "web"}, inner.remote)
+
+	inner.remote = map[string]string{"app": "web", "env": "prod"}
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.Equal(t, inner.remote, getTestLabels(ctx, readResp.State, labelsFieldName))
+	assert.Equal(t, inner.remote, getTestLabels(ctx, readResp.State, effectiveLabelsFieldName))
+}
+
+func TestWithDefaultLabels(t *testing.T) {
+	resources := withDefaultLabels(&provider_config.Config{}, []func() resource.Resource{
+		func() resource.Resource { return &fakeLabelsResource{} },
+	})
+	require.Len(t, resources, 1)
+	assert.IsType(t, &defaultLabelsResource{}, resources[0]())
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,8 +18,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/credentials"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/functions"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/airflow_cluster"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/api_gateway_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/billing_cloud_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute_disk_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute_disk_placement_group_iam_binding"
@@ -32,7 +37,14 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_community_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project_iam_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/dns_zone_records"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/iam_access_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/iam_effective_access"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/iam_token"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_cluster_kubeconfig"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_marketplace_helm_release"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/lockbox_secret_version"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/logging_group_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_database"
@@ -41,6 +53,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_cluster_beta"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_redis_cluster_v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/serverless_eventrouter_bus_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group_rule"
 	// "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group"
 )
@@ -87,6 +100,10 @@ func (p *Provider) ConfigValidators(ctx context.Context) []provider.ConfigValida
 		providervalidator.Conflicting(
 			path.MatchRoot("token"),
 			path.MatchRoot("service_account_key_file"),
+			path.MatchRoot("token_command"),
+			path.MatchRoot("token_file"),
+			path.MatchRoot("oidc_token"),
+			path.MatchRoot("oidc_token_file"),
 		),
 	}
 }
@@ -134,6 +151,35 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 					saKeyValidator{},
 				},
 			},
+			"token_command": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["token_command"],
+			},
+			"token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["token_file"],
+			},
+			"oidc_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: common.Descriptions["oidc_token"],
+			},
+			"oidc_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["oidc_token_file"],
+			},
+			"oidc_service_account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["oidc_service_account_id"],
+			},
+			"oidc_token_exchange_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["oidc_token_exchange_endpoint"],
+			},
+			"impersonate_service_account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["impersonate_service_account_id"],
+			},
 			"storage_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: common.Descriptions["storage_endpoint"],
@@ -180,6 +226,11 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"default_labels": schema.MapAttribute{
+				Optional:    true,
+				Description: common.Descriptions["default_labels"],
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -200,15 +251,15 @@ func setToDefaultBoolIfNeeded(field types.Bool, osEnvName string, defaultVal boo
 		env := os.Getenv(osEnvName)
 		v, err := strconv.ParseBool(env)
 		if err != nil {
-			return types.BoolValue(v)
+			return types.BoolValue(defaultVal)
 		}
-		return types.BoolValue(defaultVal)
+		return types.BoolValue(v)
 	}
 	return field
 }
 
 func setDefaults(config provider_config.State) provider_config.State {
-	config.Endpoint = setToDefaultIfNeeded(config.Endpoint, "YC_ENDPOINT", common.DefaultEndpoint)
+	config.Endpoint = setToDefaultIfNeeded(config.Endpoint, "YC_ENDPOINT", "")
 	config.FolderID = setToDefaultIfNeeded(config.FolderID, "YC_FOLDER_ID", "")
 	config.CloudID = setToDefaultIfNeeded(config.CloudID, "YC_CLOUD_ID", "")
 	config.OrganizationID = setToDefaultIfNeeded(config.OrganizationID, "YC_ORGANIZATION_ID", "")
@@ -216,6 +267,13 @@ func setDefaults(config provider_config.State) provider_config.State {
 	config.Zone = setToDefaultIfNeeded(config.Zone, "YC_ZONE", "")
 	config.Token = setToDefaultIfNeeded(config.Token, "YC_TOKEN", "")
 	config.ServiceAccountKeyFileOrContent = setToDefaultIfNeeded(config.ServiceAccountKeyFileOrContent, "YC_SERVICE_ACCOUNT_KEY_FILE", "")
+	config.TokenCommand = setToDefaultIfNeeded(config.TokenCommand, "YC_TOKEN_COMMAND", "")
+	config.TokenFile = setToDefaultIfNeeded(config.TokenFile, "YC_TOKEN_FILE", "")
+	config.OIDCToken = setToDefaultIfNeeded(config.OIDCToken, "YC_OIDC_TOKEN", "")
+	config.OIDCTokenFile = setToDefaultIfNeeded(config.OIDCTokenFile, "YC_OIDC_TOKEN_FILE", "")
+	config.OIDCServiceAccountID = setToDefaultIfNeeded(config.OIDCServiceAccountID, "YC_OIDC_SERVICE_ACCOUNT_ID", "")
+	config.OIDCTokenExchangeEndpoint = setToDefaultIfNeeded(config.OIDCTokenExchangeEndpoint, "YC_OIDC_TOKEN_EXCHANGE_ENDPOINT", common.DefaultOIDCTokenExchangeEndpoint)
+	config.ImpersonateServiceAccountID = setToDefaultIfNeeded(config.ImpersonateServiceAccountID, "YC_IMPERSONATE_SERVICE_ACCOUNT_ID", "")
 	config.StorageEndpoint = setToDefaultIfNeeded(config.StorageEndpoint, "YC_STORAGE_ENDPOINT_URL", common.DefaultStorageEndpoint)
 	config.StorageAccessKey = setToDefaultIfNeeded(config.StorageAccessKey, "YC_STORAGE_ACCESS_KEY", "")
 	config.StorageSecretKey = setToDefaultIfNeeded(config.StorageSecretKey, "YC_STORAGE_SECRET_KEY", "")
@@ -229,6 +287,10 @@ func setDefaults(config provider_config.State) provider_config.State {
 	if config.MaxRetries.IsUnknown() || config.MaxRetries.IsNull() {
 		config.MaxRetries = types.Int64Value(common.DefaultMaxRetries)
 	}
+	// Explicitly specified profile refers to yc CLI configuration, unless a shared credentials file is given.
+	if config.SharedCredentialsFile.ValueString() == "" && config.Profile.ValueString() != "" {
+		config.SharedCredentialsFile = types.StringValue(credentials.ExistingYCConfigFile())
+	}
 	if config.Profile.IsUnknown() || config.Profile.IsNull() {
 		config.Profile = types.StringValue("default")
 	}
@@ -251,10 +313,11 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	}
 	resp.ResourceData = &p.config
 	resp.DataSourceData = &p.config
+	resp.EphemeralResourceData = &p.config
 }
 
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return withDefaultLabels(&p.config, []func() resource.Resource{
 		func() resource.Resource {
 			return billing_cloud_binding.NewResource(
 				billing_cloud_binding.BindingServiceInstanceCloudType,
@@ -278,13 +341,19 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		compute_placement_group_iam_binding.NewIamBinding,
 		compute_snapshot_iam_binding.NewIamBinding,
 		compute_snapshot_schedule_iam_binding.NewIamBinding,
+		api_gateway_iam_binding.NewIamBinding,
+		logging_group_iam_binding.NewIamBinding,
+		serverless_eventrouter_bus_iam_binding.NewIamBinding,
+		iam_access_binding.NewIamBinding,
+		iam_access_binding.NewIamMember,
+		dns_zone_records.NewResource,
 		airflow_cluster.NewResource,
 		vpc_security_group_rule.NewResource,
 		mdb_postgresql_cluster_beta.NewPostgreSQLClusterResourceBeta,
 		mdb_redis_cluster_v2.NewResource,
 		mdb_mysql_cluster_beta.NewMySQLClusterResourceBeta,
 		kubernetes_marketplace_helm_release.NewResource,
-	}
+	})
 }
 
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
@@ -303,6 +372,25 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		mdb_redis_cluster_v2.NewDataSource,
 		mdb_opensearch_cluster.NewDataSource,
 		vpc_security_group_rule.NewDataSource,
+		kubernetes_cluster_kubeconfig.NewDataSource,
+		iam_effective_access.NewDataSource,
+	}
+}
+
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iam_token.NewEphemeralResource,
+		lockbox_secret_version.NewEphemeralResource,
+		kubernetes_cluster_kubeconfig.NewEphemeralResource,
+	}
+}
+
+func (p *Provider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseIAMMemberFunction,
+		functions.NewBucketARNFunction,
+		functions.NewYMQQueueURLToARNFunction,
+		functions.NewCIDRSubnetsForZonesFunction,
 	}
 }
 
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetToDefaultBoolIfNeeded(t *testing.T) {
+	cases := []struct {
+		name     string
+		field    types.Bool
+		env      string
+		expected types.Bool
+	}{
+		{"configured value", types.BoolValue(false), "true", types.BoolValue(false)},
+		{"env enables", types.BoolNull(), "true", types.BoolValue(true)},
+		{"env disables", types.BoolNull(), "false", types.BoolValue(false)},
+		{"invalid env falls back to default", types.BoolNull(), "yes please", types.BoolValue(false)},
+		{"unset env falls back to default", types.BoolNull(), "", types.BoolValue(false)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("YC_PLAINTEXT", tc.env)
+			assert.Equal(t, tc.expected, setToDefaultBoolIfNeeded(tc.field, "YC_PLAINTEXT", false))
+		})
+	}
+}
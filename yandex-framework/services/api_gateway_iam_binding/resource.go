@@ -0,0 +1,166 @@
+// Code generated with blueprint. You can edit it, based on your certain requirements.
+
+package api_gateway_iam_binding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type IAMUpdater struct {
+	ApiGatewayId   string
+	ProviderConfig *provider_config.Config
+}
+
+func NewIamBinding() resource.Resource {
+	return accessbinding.NewIamBinding(newIAMUpdater())
+}
+
+func newIAMUpdater() accessbinding.ResourceIamUpdater {
+	return &IAMUpdater{}
+}
+
+func (u *IAMUpdater) GetResourceIamPolicy(ctx context.Context) (*accessbinding.Policy, error) {
+	bindings, err := u.getAccessBindings(ctx, u.ApiGatewayId)
+	if err != nil {
+		return nil, err
+	}
+	return &accessbinding.Policy{Bindings: bindings}, nil
+}
+
+func (u *IAMUpdater) getAccessBindings(ctx context.Context, id string) ([]*access.AccessBinding, error) {
+	var bindings []*access.AccessBinding
+	pageToken := ""
+
+	for {
+		resp, err := u.ProviderConfig.SDK.Serverless().APIGateway().ApiGateway().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: id,
+			PageSize:   accessbinding.DefaultPageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
+
+func (u *IAMUpdater) SetResourceIamPolicy(ctx context.Context, policy *accessbinding.Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.ApiGatewayId,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, provider_config.DefaultTimeout)
+	defer cancel()
+
+	op, err := u.ProviderConfig.SDK.WrapOperation(u.ProviderConfig.SDK.Serverless().APIGateway().ApiGateway().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *IAMUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *accessbinding.PolicyDelta) error {
+	var (
+		bSize  = 1000
+		deltas = policy.Deltas
+		dLen   = len(deltas)
+	)
+
+	for i := 0; i < accessbinding.CountBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.ApiGatewayId,
+			AccessBindingDeltas: deltas[i*bSize : min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.ProviderConfig.SDK.WrapOperation(u.ProviderConfig.SDK.Serverless().APIGateway().ApiGateway().UpdateAccessBindings(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *IAMUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-api-gateway-%s", u.ApiGatewayId)
+}
+
+func (u *IAMUpdater) DescribeResource() string {
+	return fmt.Sprintf("api-gateway '%s'", u.ApiGatewayId)
+}
+
+func (u *IAMUpdater) GetNameSuffix() string {
+	return "api_gateway_iam_binding"
+}
+
+func (u *IAMUpdater) GetSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		u.GetIdAlias(): schema.StringAttribute{
+			MarkdownDescription: "The ID of the API Gateway to attach the policy to.",
+			Required:            true,
+		},
+	}
+}
+
+func (u *IAMUpdater) GetIdAlias() string {
+	return "api_gateway_id"
+}
+
+func (u *IAMUpdater) GetId() string {
+	return u.ApiGatewayId
+}
+
+func (u *IAMUpdater) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. "+
+				"Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	u.ProviderConfig = providerConfig
+}
+
+func (u *IAMUpdater) Initialize(ctx context.Context, state accessbinding.Extractable, diag *diag.Diagnostics) {
+	var id types.String
+
+	diag.Append(state.GetAttribute(ctx, path.Root("api_gateway_id"), &id)...)
+	u.ApiGatewayId = id.ValueString()
+}
@@ -0,0 +1,147 @@
+package api_gateway_iam_binding_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/apigateway/v1"
+	"google.golang.org/protobuf/proto"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const timeout = 15 * time.Minute
+
+var gatewayName = test.GenerateNameForResource(10)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccApiGateway_basicIamMember(t *testing.T) {
+	var (
+		gateway apigateway.ApiGateway
+		userID  = "allAuthenticatedUsers"
+		role    = "api-gateway.viewer"
+
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	)
+
+	defer cancel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccApiGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayWithIAMMember_basic(gatewayName, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccApiGatewayExists("yandex_api_gateway.foobar", &gateway),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Serverless().APIGateway().ApiGateway()
+					}, &gateway, role, []string{"system:" + userID}),
+				),
+			},
+			{
+				ResourceName:                         "yandex_api_gateway_iam_binding.test-binding",
+				ImportStateIdFunc:                    func(*terraform.State) (string, error) { return gateway.Id + "," + role, nil },
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "api_gateway_id",
+			},
+		},
+	})
+}
+
+func testAccApiGatewayExists(n string, gateway *apigateway.ApiGateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		found, err := config.SDK.Serverless().APIGateway().ApiGateway().Get(context.Background(), &apigateway.GetApiGatewayRequest{
+			ApiGatewayId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if found.Id != rs.Primary.ID {
+			return fmt.Errorf("API gateway not found")
+		}
+
+		proto.Reset(gateway)
+		proto.Merge(gateway, found)
+
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccApiGatewayWithIAMMember_basic(name, role, userID string) string {
+	return fmt.Sprintf(`
+resource "yandex_api_gateway" "foobar" {
+  name = "%s"
+  spec = <<-EOT
+    openapi: "3.0.0"
+    info:
+      version: 1.0.0
+      title: Test API
+    paths:
+      /hello:
+        get:
+          summary: Say hello
+          operationId: hello
+          responses:
+            '200':
+              description: Greeting
+          x-yc-apigateway-integration:
+            type: dummy
+            http_code: 200
+            content:
+              'text/plain': "Hello!"
+  EOT
+}
+
+resource "yandex_api_gateway_iam_binding" "test-binding" {
+  role = "%s"
+  members = ["system:%s"]
+  api_gateway_id = yandex_api_gateway.foobar.id
+}
+`, name, role, userID)
+}
+
+func testAccApiGatewayDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_api_gateway" {
+			continue
+		}
+
+		_, err := config.SDK.Serverless().APIGateway().ApiGateway().Get(context.Background(), &apigateway.GetApiGatewayRequest{
+			ApiGatewayId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("api gateway still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package dns_zone_records
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type zoneRecordsModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ZoneID       types.String   `tfsdk:"zone_id"`
+	Records      types.Map      `tfsdk:"records"`
+	ZoneFile     types.String   `tfsdk:"zone_file"`
+	ExcludeSoaNs types.Bool     `tfsdk:"exclude_soa_ns"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+type recordSetModel struct {
+	TTL  types.Int64 `tfsdk:"ttl"`
+	Data []string    `tfsdk:"data"`
+}
+
+var recordSetType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"ttl":  types.Int64Type,
+		"data": types.SetType{ElemType: types.StringType},
+	},
+}
+
+func expandRecords(ctx context.Context, records types.Map, diags *diag.Diagnostics) map[string]recordSet {
+	models := make(map[string]recordSetModel, len(records.Elements()))
+	diags.Append(records.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := make(map[string]recordSet, len(models))
+	for key, m := range models {
+		result[key] = recordSet{TTL: m.TTL.ValueInt64(), Data: m.Data}
+	}
+	return result
+}
+
+func flattenRecords(ctx context.Context, records map[string]recordSet, diags *diag.Diagnostics) types.Map {
+	models := make(map[string]recordSetModel, len(records))
+	for key, rs := range records {
+		models[key] = recordSetModel{TTL: types.Int64Value(rs.TTL), Data: rs.Data}
+	}
+
+	result, d := types.MapValueFrom(ctx, recordSetType, models)
+	diags.Append(d...)
+	return result
+}
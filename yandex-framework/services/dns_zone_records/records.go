@@ -0,0 +1,317 @@
+package dns_zone_records
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+const (
+	listRecordSetsPageSize = 1000
+	// maxUpsertRecordSets is the API limit of deletions, replacements and merges in a single UpsertRecordSets call.
+	maxUpsertRecordSets = 1000
+)
+
+// recordSet is a value of the `records` map, which is keyed by `{name}/{type}`.
+type recordSet struct {
+	TTL  int64
+	Data []string
+}
+
+func recordSetKey(name, typ string) string {
+	return name + "/" + typ
+}
+
+// parseRecordSetKey splits the key on the last slash, since names never contain slashes
+// and types consist of letters and digits only.
+func parseRecordSetKey(key string) (name, typ string, err error) {
+	i := strings.LastIndex(key, "/")
+	if i <= 0 || i == len(key)-1 {
+		return "", "", fmt.Errorf("invalid record set key %q, expected {name}/{type}", key)
+	}
+	return key[:i], key[i+1:], nil
+}
+
+// qualifyName returns the fully qualified name of the record set. Names ending with a dot are
+// already fully qualified, `@` stands for the zone apex and other names are relative to the zone.
+func qualifyName(name, zone string) string {
+	switch {
+	case name == "@":
+		return zone
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + zone
+	}
+}
+
+// relativeName is the inverse of qualifyName, names outside of the zone are left fully qualified.
+func relativeName(name, zone string) string {
+	switch {
+	case name == zone:
+		return "@"
+	case strings.HasSuffix(name, "."+zone):
+		return strings.TrimSuffix(name, "."+zone)
+	default:
+		return name
+	}
+}
+
+// isProviderManaged reports whether the record set is one of SOA and NS record sets at the zone apex,
+// which are created with the zone by the DNS service.
+func isProviderManaged(rs *dns.RecordSet, zone string) bool {
+	return (rs.Type == "SOA" || rs.Type == "NS") && rs.Name == zone
+}
+
+func listRecordSets(ctx context.Context, sdk *ycsdk.SDK, zoneID string) ([]*dns.RecordSet, error) {
+	var recordSets []*dns.RecordSet
+	pageToken := ""
+
+	for {
+		resp, err := sdk.DNS().DnsZone().ListRecordSets(ctx, &dns.ListDnsZoneRecordSetsRequest{
+			DnsZoneId: zoneID,
+			PageSize:  listRecordSetsPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		recordSets = append(recordSets, resp.RecordSets...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return recordSets, nil
+}
+
+// expandRecordSets converts the `records` map to record sets with fully qualified names.
+func expandRecordSets(records map[string]recordSet, zone string, excludeSoaNs bool) ([]*dns.RecordSet, error) {
+	keys := make([]string, 0, len(records))
+	for key := range records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	seen := make(map[string]string, len(keys))
+	result := make([]*dns.RecordSet, 0, len(keys))
+	for _, key := range keys {
+		name, typ, err := parseRecordSetKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		rs := &dns.RecordSet{
+			Name: qualifyName(name, zone),
+			Type: typ,
+			Ttl:  records[key].TTL,
+			Data: slices.Clone(records[key].Data),
+		}
+		sort.Strings(rs.Data)
+
+		qualified := recordSetKey(rs.Name, rs.Type)
+		if other, ok := seen[qualified]; ok {
+			return nil, fmt.Errorf("record set keys %q and %q refer to the same record set %s %s", other, key, rs.Name, rs.Type)
+		}
+		seen[qualified] = key
+
+		if excludeSoaNs && isProviderManaged(rs, zone) {
+			return nil, fmt.Errorf("record set %q is managed by the DNS service, set exclude_soa_ns to false to manage it", key)
+		}
+		result = append(result, rs)
+	}
+	return result, nil
+}
+
+// flattenRecordSets converts record sets to the `records` map. Record sets are keyed as in priorKeys,
+// if they are present there, otherwise their names are relative to the zone.
+func flattenRecordSets(recordSets []*dns.RecordSet, zone string, priorKeys []string, excludeSoaNs bool) map[string]recordSet {
+	keysByQualified := make(map[string]string, len(priorKeys))
+	for _, key := range priorKeys {
+		name, typ, err := parseRecordSetKey(key)
+		if err != nil {
+			continue
+		}
+		keysByQualified[recordSetKey(qualifyName(name, zone), typ)] = key
+	}
+
+	result := make(map[string]recordSet, len(recordSets))
+	for _, rs := range recordSets {
+		if excludeSoaNs && isProviderManaged(rs, zone) {
+			continue
+		}
+
+		key, ok := keysByQualified[recordSetKey(rs.Name, rs.Type)]
+		if !ok {
+			key = recordSetKey(relativeName(rs.Name, zone), rs.Type)
+		}
+		result[key] = recordSet{TTL: rs.Ttl, Data: rs.Data}
+	}
+	return result
+}
+
+// upsertRequest builds a request, which replaces the changed record sets and deletes the ones, which are
+// not desired. Provider managed record sets are kept, unless they are desired, when excludeSoaNs is false.
+// It returns nil, if the zone already has the desired record sets.
+func upsertRequest(zoneID, zone string, current, desired []*dns.RecordSet, excludeSoaNs bool) *dns.UpsertRecordSetsRequest {
+	currentByKey := make(map[string]*dns.RecordSet, len(current))
+	for _, rs := range current {
+		currentByKey[recordSetKey(rs.Name, rs.Type)] = rs
+	}
+
+	req := &dns.UpsertRecordSetsRequest{DnsZoneId: zoneID}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, rs := range desired {
+		key := recordSetKey(rs.Name, rs.Type)
+		desiredKeys[key] = true
+		if existing, ok := currentByKey[key]; !ok || !equalRecordSets(existing, rs) {
+			req.Replacements = append(req.Replacements, rs)
+		}
+	}
+
+	for _, rs := range current {
+		if desiredKeys[recordSetKey(rs.Name, rs.Type)] {
+			continue
+		}
+		if isProviderManaged(rs, zone) && (excludeSoaNs || rs.Type == "SOA") {
+			continue
+		}
+		req.Deletions = append(req.Deletions, rs)
+	}
+
+	if len(req.Replacements) == 0 && len(req.Deletions) == 0 {
+		return nil
+	}
+	return req
+}
+
+func equalRecordSets(a, b *dns.RecordSet) bool {
+	if a.Ttl != b.Ttl || len(a.Data) != len(b.Data) {
+		return false
+	}
+	aData, bData := slices.Clone(a.Data), slices.Clone(b.Data)
+	sort.Strings(aData)
+	sort.Strings(bData)
+	return slices.Equal(aData, bData)
+}
+
+// applyRecordSets makes the record sets of the zone match the records with as few UpsertRecordSets calls
+// as the API limits allow, see upsertRecordSets.
+func applyRecordSets(ctx context.Context, sdk *ycsdk.SDK, zoneID string, records map[string]recordSet, excludeSoaNs bool) error {
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{DnsZoneId: zoneID})
+	if err != nil {
+		return fmt.Errorf("error getting DNS zone %q: %w", zoneID, err)
+	}
+
+	desired, err := expandRecordSets(records, zone.Zone, excludeSoaNs)
+	if err != nil {
+		return err
+	}
+
+	current, err := listRecordSets(ctx, sdk, zoneID)
+	if err != nil {
+		return fmt.Errorf("error listing record sets of DNS zone %q: %w", zoneID, err)
+	}
+
+	req := upsertRequest(zoneID, zone.Zone, current, desired, excludeSoaNs)
+	if req == nil {
+		return nil
+	}
+
+	if err := upsertRecordSets(ctx, sdk, req); err != nil {
+		return fmt.Errorf("error upserting record sets of DNS zone %q: %w", zoneID, err)
+	}
+	return nil
+}
+
+// splitUpsertRequest splits the request to requests, which fit in the API limits. The request is kept
+// as is, if it fits. Otherwise deletions are sent before replacements, so e.g. a CNAME record set may
+// replace record sets of other types with the same name.
+func splitUpsertRequest(req *dns.UpsertRecordSetsRequest) []*dns.UpsertRecordSetsRequest {
+	if len(req.Deletions) == 0 && len(req.Replacements) == 0 {
+		return nil
+	}
+	if len(req.Deletions) <= maxUpsertRecordSets && len(req.Replacements) <= maxUpsertRecordSets {
+		return []*dns.UpsertRecordSetsRequest{req}
+	}
+
+	var result []*dns.UpsertRecordSetsRequest
+	for _, chunk := range chunkRecordSets(req.Deletions) {
+		result = append(result, &dns.UpsertRecordSetsRequest{DnsZoneId: req.DnsZoneId, Deletions: chunk})
+	}
+	for _, chunk := range chunkRecordSets(req.Replacements) {
+		result = append(result, &dns.UpsertRecordSetsRequest{DnsZoneId: req.DnsZoneId, Replacements: chunk})
+	}
+	return result
+}
+
+func chunkRecordSets(recordSets []*dns.RecordSet) [][]*dns.RecordSet {
+	var chunks [][]*dns.RecordSet
+	for len(recordSets) > maxUpsertRecordSets {
+		chunks = append(chunks, recordSets[:maxUpsertRecordSets])
+		recordSets = recordSets[maxUpsertRecordSets:]
+	}
+	if len(recordSets) > 0 {
+		chunks = append(chunks, recordSets)
+	}
+	return chunks
+}
+
+// upsertRecordSets sends the request in chunks, so changes of more than maxUpsertRecordSets record sets
+// are not atomic: if a chunk fails, the preceding chunks remain applied.
+func upsertRecordSets(ctx context.Context, sdk *ycsdk.SDK, req *dns.UpsertRecordSetsRequest) error {
+	for _, chunk := range splitUpsertRequest(req) {
+		op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpsertRecordSets(ctx, chunk))
+		if err != nil {
+			return err
+		}
+		if err := op.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRecordSets returns the record sets of the zone keyed as in priorKeys, see flattenRecordSets.
+func readRecordSets(ctx context.Context, sdk *ycsdk.SDK, zoneID string, priorKeys []string, excludeSoaNs bool) (map[string]recordSet, error) {
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{DnsZoneId: zoneID})
+	if err != nil {
+		return nil, err
+	}
+
+	recordSets, err := listRecordSets(ctx, sdk, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	return flattenRecordSets(recordSets, zone.Zone, priorKeys, excludeSoaNs), nil
+}
+
+// deleteRecordSets deletes the records from the zone with UpsertRecordSets calls. SOA and NS
+// record sets at the zone apex are kept, since they can not be deleted along with the zone.
+func deleteRecordSets(ctx context.Context, sdk *ycsdk.SDK, zoneID string, records map[string]recordSet) error {
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{DnsZoneId: zoneID})
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := expandRecordSets(records, zone.Zone, false)
+	if err != nil {
+		return err
+	}
+
+	req := &dns.UpsertRecordSetsRequest{DnsZoneId: zoneID}
+	for _, rs := range recordSets {
+		if !isProviderManaged(rs, zone.Zone) {
+			req.Deletions = append(req.Deletions, rs)
+		}
+	}
+	return upsertRecordSets(ctx, sdk, req)
+}
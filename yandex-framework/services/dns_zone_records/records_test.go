@@ -0,0 +1,234 @@
+package dns_zone_records
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const testZone = "example.com."
+
+func newFakeCloudConfig(t *testing.T) (*provider_config.Config, *fakecloud.Server) {
+	server := fakecloud.New(t)
+
+	c := &provider_config.Config{
+		ProviderState: provider_config.State{
+			Endpoint:   types.StringValue(server.Endpoint()),
+			Plaintext:  types.BoolValue(true),
+			Token:      types.StringValue(fakecloud.Token),
+			MaxRetries: types.Int64Value(1),
+		},
+	}
+	require.NoError(t, c.InitAndValidate(context.Background(), "1.10.0", false))
+	return c, server
+}
+
+func createZone(t *testing.T, config *provider_config.Config) string {
+	ctx := context.Background()
+
+	op, err := config.SDK.WrapOperation(config.SDK.DNS().DnsZone().Create(ctx, &dns.CreateDnsZoneRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "zone",
+		Zone:     testZone,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	return md.(*dns.CreateDnsZoneMetadata).DnsZoneId
+}
+
+// recordSetStrings formats record sets for comparison, since protos must not be compared with assert.Equal.
+func recordSetStrings(recordSets []*dns.RecordSet) []string {
+	result := make([]string, 0, len(recordSets))
+	for _, rs := range recordSets {
+		result = append(result, recordSetKey(rs.Name, rs.Type)+" "+strings.Join(rs.Data, ","))
+	}
+	return result
+}
+
+func TestRecordSetsLifecycle(t *testing.T) {
+	ctx := context.Background()
+	config, server := newFakeCloudConfig(t)
+	zoneID := createZone(t, config)
+
+	_, err := config.SDK.WrapOperation(config.SDK.DNS().DnsZone().UpsertRecordSets(ctx, &dns.UpsertRecordSetsRequest{
+		DnsZoneId:    zoneID,
+		Replacements: []*dns.RecordSet{{Name: "old.example.com.", Type: "A", Ttl: 300, Data: []string{"10.0.0.1"}}},
+	}))
+	require.NoError(t, err)
+
+	records := map[string]recordSet{
+		"@/MX":                 {TTL: 3600, Data: []string{"10 mx.example.com."}},
+		"www/A":                {TTL: 300, Data: []string{"10.0.0.2", "10.0.0.1"}},
+		"api.example.com./TXT": {TTL: 60, Data: []string{`"v=1"`}},
+	}
+	require.NoError(t, applyRecordSets(ctx, config.SDK, zoneID, records, true))
+
+	assert.Equal(t, []string{
+		"api.example.com./TXT \"v=1\"",
+		"example.com./MX 10 mx.example.com.",
+		"example.com./NS ns1.yandexcloud.net.,ns2.yandexcloud.net.",
+		"example.com./SOA ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900",
+		"www.example.com./A 10.0.0.1,10.0.0.2",
+	}, recordSetStrings(server.RecordSets(zoneID)))
+
+	read, err := readRecordSets(ctx, config.SDK, zoneID, []string{"api.example.com./TXT"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]recordSet{
+		"@/MX":                 {TTL: 3600, Data: []string{"10 mx.example.com."}},
+		"www/A":                {TTL: 300, Data: []string{"10.0.0.1", "10.0.0.2"}},
+		"api.example.com./TXT": {TTL: 60, Data: []string{`"v=1"`}},
+	}, read)
+
+	read, err = readRecordSets(ctx, config.SDK, zoneID, nil, false)
+	require.NoError(t, err)
+	assert.Contains(t, read, "@/SOA")
+	assert.Contains(t, read, "@/NS")
+	assert.Contains(t, read, "api/TXT")
+
+	delete(records, "@/MX")
+	require.NoError(t, deleteRecordSets(ctx, config.SDK, zoneID, records))
+	assert.Equal(t, []string{
+		"example.com./MX 10 mx.example.com.",
+		"example.com./NS ns1.yandexcloud.net.,ns2.yandexcloud.net.",
+		"example.com./SOA ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900",
+	}, recordSetStrings(server.RecordSets(zoneID)))
+
+	_, err = readRecordSets(ctx, config.SDK, "dnsmissing", nil, true)
+	assert.True(t, validate.IsStatusWithCode(err, codes.NotFound), "unexpected error: %v", err)
+}
+
+func TestApplyRecordSetsRejectsProviderManaged(t *testing.T) {
+	ctx := context.Background()
+	config, _ := newFakeCloudConfig(t)
+	zoneID := createZone(t, config)
+
+	err := applyRecordSets(ctx, config.SDK, zoneID, map[string]recordSet{
+		"@/NS": {TTL: 3600, Data: []string{"ns1.example.net."}},
+	}, true)
+	assert.ErrorContains(t, err, `record set "@/NS" is managed by the DNS service`)
+
+	err = applyRecordSets(ctx, config.SDK, zoneID, map[string]recordSet{
+		"www/A":              {TTL: 300, Data: []string{"10.0.0.1"}},
+		"www.example.com./A": {TTL: 300, Data: []string{"10.0.0.2"}},
+	}, true)
+	assert.ErrorContains(t, err, "refer to the same record set")
+}
+
+func TestApplyRecordSetsInChunks(t *testing.T) {
+	ctx := context.Background()
+	config, server := newFakeCloudConfig(t)
+	zoneID := createZone(t, config)
+
+	tooMany := make([]*dns.RecordSet, maxUpsertRecordSets+1)
+	for i := range tooMany {
+		tooMany[i] = &dns.RecordSet{Name: fmt.Sprintf("host%d.example.com.", i), Type: "A", Ttl: 300, Data: []string{"192.0.2.1"}}
+	}
+	_, err := config.SDK.DNS().DnsZone().UpsertRecordSets(ctx, &dns.UpsertRecordSetsRequest{DnsZoneId: zoneID, Replacements: tooMany})
+	assert.True(t, validate.IsStatusWithCode(err, codes.InvalidArgument), "unexpected error: %v", err)
+
+	records := make(map[string]recordSet, 2500)
+	for i := 0; i < 2500; i++ {
+		records[fmt.Sprintf("host%d/A", i)] = recordSet{TTL: 300, Data: []string{"192.0.2.1"}}
+	}
+	require.NoError(t, applyRecordSets(ctx, config.SDK, zoneID, records, true))
+	assert.Len(t, server.RecordSets(zoneID), 2502, "2500 record sets and apex SOA and NS are expected")
+
+	updated := make(map[string]recordSet, 1500)
+	for i := 1000; i < 2500; i++ {
+		updated[fmt.Sprintf("host%d/A", i)] = recordSet{TTL: 600, Data: []string{"192.0.2.2"}}
+	}
+	require.NoError(t, applyRecordSets(ctx, config.SDK, zoneID, updated, true))
+
+	read, err := readRecordSets(ctx, config.SDK, zoneID, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, updated, read)
+
+	require.NoError(t, deleteRecordSets(ctx, config.SDK, zoneID, updated))
+	assert.Len(t, server.RecordSets(zoneID), 2)
+}
+
+func TestSplitUpsertRequest(t *testing.T) {
+	recordSets := make([]*dns.RecordSet, 2*maxUpsertRecordSets+1)
+	for i := range recordSets {
+		recordSets[i] = &dns.RecordSet{Name: fmt.Sprintf("host%d.example.com.", i), Type: "A"}
+	}
+
+	chunks := splitUpsertRequest(&dns.UpsertRecordSetsRequest{
+		DnsZoneId:    "dns1",
+		Deletions:    recordSets[:maxUpsertRecordSets+1],
+		Replacements: recordSets,
+	})
+	require.Len(t, chunks, 5)
+
+	var sizes [][2]int
+	for _, chunk := range chunks {
+		assert.Equal(t, "dns1", chunk.DnsZoneId)
+		sizes = append(sizes, [2]int{len(chunk.Deletions), len(chunk.Replacements)})
+	}
+	assert.Equal(t, [][2]int{{1000, 0}, {1, 0}, {0, 1000}, {0, 1000}, {0, 1}}, sizes)
+	assert.Empty(t, splitUpsertRequest(&dns.UpsertRecordSetsRequest{DnsZoneId: "dns1"}))
+
+	req := &dns.UpsertRecordSetsRequest{
+		DnsZoneId:    "dns1",
+		Deletions:    recordSets[:maxUpsertRecordSets],
+		Replacements: recordSets[maxUpsertRecordSets : 2*maxUpsertRecordSets],
+	}
+	assert.Equal(t, []*dns.UpsertRecordSetsRequest{req}, splitUpsertRequest(req))
+}
+
+func TestUpsertRequest(t *testing.T) {
+	current := []*dns.RecordSet{
+		{Name: testZone, Type: "NS", Ttl: 3600, Data: []string{"ns1.yandexcloud.net."}},
+		{Name: testZone, Type: "SOA", Ttl: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900"}},
+		{Name: "same.example.com.", Type: "A", Ttl: 300, Data: []string{"10.0.0.2", "10.0.0.1"}},
+		{Name: "ttl.example.com.", Type: "A", Ttl: 300, Data: []string{"10.0.0.1"}},
+		{Name: "stale.example.com.", Type: "A", Ttl: 300, Data: []string{"10.0.0.1"}},
+	}
+	desired := []*dns.RecordSet{
+		{Name: "same.example.com.", Type: "A", Ttl: 300, Data: []string{"10.0.0.1", "10.0.0.2"}},
+		{Name: "ttl.example.com.", Type: "A", Ttl: 600, Data: []string{"10.0.0.1"}},
+		{Name: "new.example.com.", Type: "CNAME", Ttl: 300, Data: []string{"same.example.com."}},
+	}
+
+	req := upsertRequest("dns1", testZone, current, desired, true)
+	require.NotNil(t, req)
+	assert.Equal(t, []string{
+		"ttl.example.com./A 10.0.0.1",
+		"new.example.com./CNAME same.example.com.",
+	}, recordSetStrings(req.Replacements))
+	assert.Equal(t, []string{"stale.example.com./A 10.0.0.1"}, recordSetStrings(req.Deletions))
+
+	req = upsertRequest("dns1", testZone, current, desired, false)
+	assert.Equal(t, []string{
+		"example.com./NS ns1.yandexcloud.net.",
+		"stale.example.com./A 10.0.0.1",
+	}, recordSetStrings(req.Deletions))
+
+	assert.Nil(t, upsertRequest("dns1", testZone, current, current, true))
+}
+
+func TestQualifyName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"@":                testZone,
+		"www":              "www.example.com.",
+		"a.b":              "a.b.example.com.",
+		"www.example.org.": "www.example.org.",
+	} {
+		assert.Equal(t, expected, qualifyName(name, testZone))
+		assert.Equal(t, name, relativeName(expected, testZone))
+	}
+}
@@ -0,0 +1,306 @@
+package dns_zone_records
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &zoneRecordsResource{}
+	_ resource.ResourceWithConfigure   = &zoneRecordsResource{}
+	_ resource.ResourceWithImportState = &zoneRecordsResource{}
+	_ resource.ResourceWithModifyPlan  = &zoneRecordsResource{}
+)
+
+var recordSetKeyRegexp = regexp.MustCompile(`^[^/\s]+/[A-Z][A-Z0-9]*$`)
+
+type zoneRecordsResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &zoneRecordsResource{}
+}
+
+func (r *zoneRecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_records"
+}
+
+func (r *zoneRecordsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages all record sets of a DNS zone. Record sets, which are not listed in the resource, are deleted from the zone.",
+		Attributes: map[string]schema.Attribute{
+			"id": defaultschema.Id(),
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the DNS zone.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.MapNestedAttribute{
+				MarkdownDescription: "The record sets of the zone keyed by `{name}/{type}`, e.g. `www/A`. The name is relative to the zone, `@` stands for the zone apex and names ending with a dot are fully qualified. Computed from `zone_file`, if it is set.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "The time-to-live of the record set in seconds.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 2147483647),
+							},
+						},
+						"data": schema.SetAttribute{
+							MarkdownDescription: "The records of the record set.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 100),
+								setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 1024)),
+							},
+						},
+					},
+				},
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(recordSetKeyRegexp,
+						"must be in {name}/{type} format with the type in upper case, e.g. www/A")),
+				},
+			},
+			"zone_file": schema.StringAttribute{
+				MarkdownDescription: "The contents of a zone file in BIND format to take the record sets from instead of `records`. Owner names under `$ORIGIN` are made relative to it, `$INCLUDE` and `$GENERATE` directives are not supported.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("records")),
+				},
+			},
+			"exclude_soa_ns": schema.BoolAttribute{
+				MarkdownDescription: "Whether to ignore SOA and NS record sets at the zone apex, which are created with the zone by the DNS service. Defaults to `true`. If set to `false`, the apex NS record set is managed too and SOA record set may be updated, but it is never deleted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan computes `records` from `zone_file`, so changes of the record sets are shown in the plan.
+func (r *zoneRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var zoneFile types.String
+	var excludeSoaNs types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone_file"), &zoneFile)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("exclude_soa_ns"), &excludeSoaNs)...)
+	if resp.Diagnostics.HasError() || zoneFile.IsNull() {
+		return
+	}
+
+	if zoneFile.IsUnknown() || excludeSoaNs.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), types.MapUnknown(recordSetType))...)
+		return
+	}
+
+	records, err := parseZoneFile(zoneFile.ValueString(), excludeSoaNs.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "Invalid Zone File", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), flattenRecords(ctx, records, &resp.Diagnostics))...)
+}
+
+func (r *zoneRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan zoneRecordsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, provider_config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ZoneID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *zoneRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state zoneRecordsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorKeys []string
+	if !state.Records.IsNull() && !state.Records.IsUnknown() {
+		for key := range state.Records.Elements() {
+			priorKeys = append(priorKeys, key)
+		}
+	}
+
+	records, err := readRecordSets(ctx, r.providerConfig.SDK, state.ZoneID.ValueString(), priorKeys, state.ExcludeSoaNs.ValueBool())
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			tflog.Debug(ctx, fmt.Sprintf("DNS zone %s is missing or deleted", state.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			fmt.Sprintf("An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+
+	state.ID = state.ZoneID
+	state.Records = flattenRecords(ctx, records, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *zoneRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan zoneRecordsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, provider_config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ZoneID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *zoneRecordsResource) apply(ctx context.Context, plan *zoneRecordsModel, diags *diag.Diagnostics) {
+	records := expandRecords(ctx, plan.Records, diags)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Applying %d record sets to DNS zone %s", len(records), plan.ZoneID.ValueString()))
+	err := applyRecordSets(ctx, r.providerConfig.SDK, plan.ZoneID.ValueString(), records, plan.ExcludeSoaNs.ValueBool())
+	if err != nil {
+		diags.AddError(
+			"Unable to Update Record Sets",
+			fmt.Sprintf("An unexpected error occurred while updating record sets of the DNS zone. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+	}
+}
+
+func (r *zoneRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state zoneRecordsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, provider_config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	records := expandRecords(ctx, state.Records, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteRecordSets(ctx, r.providerConfig.SDK, state.ZoneID.ValueString(), records)
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			tflog.Debug(ctx, fmt.Sprintf("DNS zone %s is missing or deleted", state.ZoneID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			fmt.Sprintf("An unexpected error occurred while attempting to delete record sets of the DNS zone. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+	}
+}
+
+// ImportState imports all record sets of the zone by its ID. Apex SOA and NS record sets are excluded.
+func (r *zoneRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exclude_soa_ns"), true)...)
+}
+
+func (r *zoneRecordsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
@@ -0,0 +1,196 @@
+package dns_zone_records_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testCheckFakeCloudRecordSets(server *fakecloud.Server, zoneID string, expected ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var got []string
+		for _, rs := range server.RecordSets(zoneID) {
+			if rs.Type != "SOA" && !(rs.Type == "NS" && rs.Name == "example.com.") {
+				got = append(got, fmt.Sprintf("%s %s %d %v", rs.Name, rs.Type, rs.Ttl, rs.Data))
+			}
+		}
+		sort.Strings(expected)
+		if fmt.Sprint(got) != fmt.Sprint(expected) {
+			return fmt.Errorf("expected record sets %v, got %v", expected, got)
+		}
+		return nil
+	}
+}
+
+func createFakeCloudZone(t *testing.T, server *fakecloud.Server) string {
+	ctx := context.Background()
+	sdk, err := ycsdk.Build(ctx, ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(fakecloud.Token),
+		Endpoint:    server.Endpoint(),
+		Plaintext:   true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(ctx) })
+
+	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().Create(ctx, &dns.CreateDnsZoneRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "zone",
+		Zone:     "example.com.",
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	return md.(*dns.CreateDnsZoneMetadata).DnsZoneId
+}
+
+func TestUnitDnsZoneRecords_fakeCloud(t *testing.T) {
+	fakecloud.SkipWithoutTerraform(t)
+	fakecloud.IsolateEnv(t)
+
+	server := fakecloud.New(t)
+	zoneID := createFakeCloudZone(t, server)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testCheckFakeCloudRecordSets(server, zoneID),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "yandex_dns_zone_records" "test" {
+  zone_id = "%s"
+
+  records = {
+    "www/A" = {
+      ttl  = 300
+      data = ["192.0.2.1", "192.0.2.2"]
+    }
+    "@/MX" = {
+      ttl  = 3600
+      data = ["10 mx.example.com."]
+    }
+  }
+}
+`, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeCloudRecordSets(server, zoneID,
+						"example.com. MX 3600 [10 mx.example.com.]",
+						"www.example.com. A 300 [192.0.2.1 192.0.2.2]",
+					),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "records.%", "2"),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "exclude_soa_ns", "true"),
+				),
+			},
+			{
+				ResourceName:      "yandex_dns_zone_records.test",
+				ImportState:       true,
+				ImportStateId:     zoneID,
+				ImportStateVerify: true,
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "yandex_dns_zone_records" "test" {
+  zone_id   = "%s"
+  zone_file = <<-EOT
+    $ORIGIN example.com.
+    $TTL 600
+    @    SOA ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900
+    @    NS  ns1.yandexcloud.net.
+    www  A   192.0.2.3
+    api  CNAME www
+  EOT
+}
+`, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeCloudRecordSets(server, zoneID,
+						"api.example.com. CNAME 600 [www]",
+						"www.example.com. A 600 [192.0.2.3]",
+					),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "records.%", "2"),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "records.www/A.ttl", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_basic(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix(test.TestPrefix())
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsZoneRecordsConfig(zoneName, fqdn, `
+  records = {
+    "srv/A" = {
+      ttl  = 200
+      data = ["192.168.0.1", "192.168.0.2"]
+    }
+    "@/TXT" = {
+      ttl  = 300
+      data = ["\"v=spf1 -all\""]
+    }
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("yandex_dns_zone_records.test", "zone_id", "yandex_dns_zone.test", "id"),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "records.%", "2"),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "records.srv/A.data.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "yandex_dns_zone_records.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDnsZoneRecordsConfig(zoneName, fqdn, `
+  zone_file = <<-EOT
+    $TTL 600
+    srv  A     192.168.0.3
+    www  CNAME srv
+  EOT
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "records.%", "2"),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.test", "records.srv/A.ttl", "600"),
+					resource.TestCheckNoResourceAttr("yandex_dns_zone_records.test", "records.@/TXT.ttl"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDnsZoneRecordsConfig(name, fqdn, records string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "test" {
+  name = "%s"
+  zone = "%s"
+}
+
+resource "yandex_dns_zone_records" "test" {
+  zone_id = yandex_dns_zone.test.id
+%s}
+`, name, fqdn, records)
+}
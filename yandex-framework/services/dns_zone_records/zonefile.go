@@ -0,0 +1,239 @@
+package dns_zone_records
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ttlRegexp        = regexp.MustCompile(`^(?i)(\d+[smhdw]?)+$`)
+	ttlPartRegexp    = regexp.MustCompile(`(?i)(\d+)([smhdw]?)`)
+	recordTypeRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9]*$`)
+	ttlUnits         = map[string]int64{"": 1, "s": 1, "m": 60, "h": 3600, "d": 86400, "w": 604800}
+	dnsClasses       = map[string]bool{"IN": true, "CH": true, "HS": true, "CS": true}
+)
+
+// parseZoneFile parses a zone file in BIND format to the `records` map. Owner names under `$ORIGIN`
+// are made relative to it, so they are relative to the zone, which the records are applied to.
+// Without `$ORIGIN`, owner names are kept as written. If excludeSoaNs is set, SOA record sets
+// and NS record sets at the apex are skipped.
+//
+// `$ORIGIN` and `$TTL` directives, multi-line records in parentheses, quoted strings and
+// comments are supported, `$INCLUDE` and `$GENERATE` directives are not.
+func parseZoneFile(content string, excludeSoaNs bool) (map[string]recordSet, error) {
+	records := make(map[string]recordSet)
+	origin := ""
+	owner := ""
+	defaultTTL := int64(-1)
+	lastTTL := int64(-1)
+
+	lines, err := zoneFileEntries(content)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if strings.HasPrefix(tokens[0], "$") {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 || !strings.HasSuffix(tokens[1], ".") {
+					return nil, fmt.Errorf("line %d: $ORIGIN must be a fully qualified name", line.number)
+				}
+				origin = tokens[1]
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL must have a single value", line.number)
+				}
+				defaultTTL, err = parseTTL(tokens[1])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", line.number, tokens[0])
+			}
+			continue
+		}
+
+		if !line.continued {
+			owner, tokens = tokens[0], tokens[1:]
+			if origin != "" {
+				owner = relativeName(qualifyName(owner, origin), origin)
+			}
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.number)
+		}
+
+		ttl := int64(-1)
+		for len(tokens) > 0 {
+			if dnsClasses[strings.ToUpper(tokens[0])] {
+				tokens = tokens[1:]
+				continue
+			}
+			if ttl < 0 && ttlRegexp.MatchString(tokens[0]) {
+				ttl, err = parseTTL(tokens[0])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: expected record type and data", line.number)
+		}
+		typ := strings.ToUpper(tokens[0])
+		if !recordTypeRegexp.MatchString(typ) {
+			return nil, fmt.Errorf("line %d: invalid record type %q", line.number, tokens[0])
+		}
+		data := strings.Join(tokens[1:], " ")
+
+		switch {
+		case ttl >= 0:
+		case defaultTTL >= 0:
+			ttl = defaultTTL
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: TTL is not set, add it to the record or add $TTL directive", line.number)
+		}
+		lastTTL = ttl
+
+		if excludeSoaNs && (typ == "SOA" || typ == "NS" && owner == "@") {
+			continue
+		}
+
+		key := recordSetKey(owner, typ)
+		rs, ok := records[key]
+		if !ok {
+			rs.TTL = ttl
+		}
+		rs.Data = append(rs.Data, data)
+		records[key] = rs
+	}
+
+	return records, nil
+}
+
+func parseTTL(value string) (int64, error) {
+	if !ttlRegexp.MatchString(value) {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	var ttl int64
+	for _, m := range ttlPartRegexp.FindAllStringSubmatch(value, -1) {
+		n, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid TTL %q: %w", value, err)
+		}
+		ttl += n * ttlUnits[strings.ToLower(m[2])]
+	}
+	return ttl, nil
+}
+
+// zoneFileEntry is a record or a directive, which may span several lines.
+type zoneFileEntry struct {
+	number    int
+	continued bool
+	tokens    []string
+}
+
+// zoneFileEntries splits the zone file to entries, dropping comments and joining lines in parentheses.
+// Entries, which start with a blank, continue the owner name of the previous record.
+func zoneFileEntries(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var current *zoneFileEntry
+	depth := 0
+
+	for i, text := range strings.Split(content, "\n") {
+		tokens, delta, err := tokenizeZoneFileLine(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		if current == nil {
+			if len(tokens) == 0 {
+				continue
+			}
+			current = &zoneFileEntry{
+				number:    i + 1,
+				continued: text[0] == ' ' || text[0] == '\t',
+			}
+		}
+		current.tokens = append(current.tokens, tokens...)
+
+		depth += delta
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", i+1)
+		}
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				entries = append(entries, *current)
+			}
+			current = nil
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+	return entries, nil
+}
+
+// tokenizeZoneFileLine splits the line to blank separated tokens and returns them along with the change
+// of parentheses depth. Quoted strings are kept as a single token with quotes.
+func tokenizeZoneFileLine(text string) ([]string, int, error) {
+	var tokens []string
+	var token strings.Builder
+	delta := 0
+	quoted := false
+
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quoted:
+			token.WriteByte(c)
+			if c == '\\' && i+1 < len(text) {
+				i++
+				token.WriteByte(text[i])
+			} else if c == '"' {
+				quoted = false
+			}
+		case c == '"':
+			token.WriteByte(c)
+			quoted = true
+		case c == ';':
+			flush()
+			return tokens, delta, nil
+		case c == '(' || c == ')':
+			flush()
+			if c == '(' {
+				delta++
+			} else {
+				delta--
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		default:
+			token.WriteByte(c)
+		}
+	}
+
+	if quoted {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+	return tokens, delta, nil
+}
@@ -0,0 +1,74 @@
+package dns_zone_records
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testZoneFile = `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA ns1.yandexcloud.net. mx.cloud.yandex.net. (
+                1          ; serial
+                10800      ; refresh
+                900        ; retry
+                604800     ; expire
+                900 )      ; minimum
+@           NS  ns1.yandexcloud.net.
+@           NS  ns2.yandexcloud.net.
+@       300 IN  A   192.0.2.1
+            IN  MX  10 mx.example.com.
+www     IN  300 A   192.0.2.2
+www         A   192.0.2.3
+mail.example.com. A 192.0.2.4
+sub         NS  ns.example.org.
+txt     60  TXT "v=spf1 -all ; not a comment" "second"
+ext.example.org. 1d CNAME www.example.com.
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := parseZoneFile(testZoneFile, true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]recordSet{
+		"@/A":                    {TTL: 300, Data: []string{"192.0.2.1"}},
+		"@/MX":                   {TTL: 3600, Data: []string{"10 mx.example.com."}},
+		"www/A":                  {TTL: 300, Data: []string{"192.0.2.2", "192.0.2.3"}},
+		"mail/A":                 {TTL: 3600, Data: []string{"192.0.2.4"}},
+		"sub/NS":                 {TTL: 3600, Data: []string{"ns.example.org."}},
+		"txt/TXT":                {TTL: 60, Data: []string{`"v=spf1 -all ; not a comment" "second"`}},
+		"ext.example.org./CNAME": {TTL: 86400, Data: []string{"www.example.com."}},
+	}, records)
+
+	records, err = parseZoneFile(testZoneFile, false)
+	require.NoError(t, err)
+	assert.Equal(t, recordSet{TTL: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900"}}, records["@/SOA"])
+	assert.Equal(t, recordSet{TTL: 3600, Data: []string{"ns1.yandexcloud.net.", "ns2.yandexcloud.net."}}, records["@/NS"])
+}
+
+func TestParseZoneFileWithoutOrigin(t *testing.T) {
+	records, err := parseZoneFile("www 300 A 192.0.2.1\n@ A 192.0.2.2\nfqdn.example.com. 1h30m A 192.0.2.3\n", true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]recordSet{
+		"www/A":               {TTL: 300, Data: []string{"192.0.2.1"}},
+		"@/A":                 {TTL: 300, Data: []string{"192.0.2.2"}},
+		"fqdn.example.com./A": {TTL: 5400, Data: []string{"192.0.2.3"}},
+	}, records)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	for content, expected := range map[string]string{
+		"www A 192.0.2.1":                     "line 1: TTL is not set",
+		"$TTL 300\n$INCLUDE other.zone":       "line 2: unsupported directive $INCLUDE",
+		"$ORIGIN example.com":                 "line 1: $ORIGIN must be a fully qualified name",
+		"$TTL 300\n  A 192.0.2.1":             "line 2: record has no owner name",
+		"$TTL 300\n@ SOA ns. mx. ( 1 2 3 4 5": "line 2: unbalanced parentheses",
+		"$TTL 300\nwww TXT \"unterminated":    "line 2: unterminated quoted string",
+		"$TTL 300\nwww 300":                   "line 2: expected record type and data",
+		"$TTL 300\nwww a-b 192.0.2.1":         `line 2: invalid record type "a-b"`,
+	} {
+		_, err := parseZoneFile(content, true)
+		assert.ErrorContains(t, err, expected, content)
+	}
+}
@@ -0,0 +1,137 @@
+package iam_access_binding
+
+import (
+	"context"
+	"sort"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
+)
+
+// accessBindingsClient is implemented by all service clients of resources with access bindings.
+type accessBindingsClient interface {
+	ListAccessBindings(ctx context.Context, in *access.ListAccessBindingsRequest, opts ...grpc.CallOption) (*access.ListAccessBindingsResponse, error)
+	SetAccessBindings(ctx context.Context, in *access.SetAccessBindingsRequest, opts ...grpc.CallOption) (*operation.Operation, error)
+	UpdateAccessBindings(ctx context.Context, in *access.UpdateAccessBindingsRequest, opts ...grpc.CallOption) (*operation.Operation, error)
+}
+
+type clientFunc func(sdk *ycsdk.SDK) accessBindingsClient
+
+// resourceTypes maps supported values of `resource_type` to the service clients managing
+// access bindings of the resources.
+//
+// VPC, Application Load Balancer and Managed Databases resources are not listed,
+// since their APIs do not provide access bindings methods.
+var resourceTypes = map[string]clientFunc{
+	"certificate-manager.certificate": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Certificates().Certificate()
+	},
+	"compute.disk": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().Disk()
+	},
+	"compute.disk-placement-group": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().DiskPlacementGroup()
+	},
+	"compute.filesystem": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().Filesystem()
+	},
+	"compute.gpu-cluster": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().GpuCluster()
+	},
+	"compute.host-group": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().HostGroup()
+	},
+	"compute.image": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().Image()
+	},
+	"compute.instance": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().Instance()
+	},
+	"compute.placement-group": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().PlacementGroup()
+	},
+	"compute.snapshot": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().Snapshot()
+	},
+	"compute.snapshot-schedule": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Compute().SnapshotSchedule()
+	},
+	"container-registry.registry": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.ContainerRegistry().Registry()
+	},
+	"container-registry.repository": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.ContainerRegistry().Repository()
+	},
+	"datasphere.community": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Datasphere().Community()
+	},
+	"datasphere.project": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Datasphere().Project()
+	},
+	"dns.zone": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.DNS().DnsZone()
+	},
+	"iam.service-account": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.IAM().ServiceAccount()
+	},
+	"kms.asymmetric-encryption-key": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.KMSAsymmetricEncryption().AsymmetricEncryptionKey()
+	},
+	"kms.asymmetric-signature-key": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.KMSAsymmetricSignature().AsymmetricSignatureKey()
+	},
+	"kms.symmetric-key": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.KMS().SymmetricKey()
+	},
+	"lockbox.secret": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.LockboxSecret().Secret()
+	},
+	"logging.group": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Logging().LogGroup()
+	},
+	"organization-manager.group": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.OrganizationManager().Group()
+	},
+	"organization-manager.organization": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.OrganizationManager().Organization()
+	},
+	"resource-manager.cloud": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.ResourceManager().Cloud()
+	},
+	"resource-manager.folder": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.ResourceManager().Folder()
+	},
+	"serverless.api-gateway": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Serverless().APIGateway().ApiGateway()
+	},
+	"serverless.container": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Serverless().Containers().Container()
+	},
+	"serverless.eventrouter.bus": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Serverless().Eventrouter().Bus()
+	},
+	"serverless.eventrouter.connector": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Serverless().Eventrouter().Connector()
+	},
+	"serverless.eventrouter.rule": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Serverless().Eventrouter().Rule()
+	},
+	"serverless.function": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.Serverless().Functions().Function()
+	},
+	"ydb.database": func(sdk *ycsdk.SDK) accessBindingsClient {
+		return sdk.YDB().Database()
+	},
+}
+
+// ResourceTypes returns supported values of `resource_type` in alphabetical order.
+func ResourceTypes() []string {
+	types := make([]string, 0, len(resourceTypes))
+	for t := range resourceTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,222 @@
+package iam_access_binding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// IAMUpdater manages access bindings of any resource registered in resourceTypes.
+type IAMUpdater struct {
+	ResourceType   string
+	ResourceId     string
+	ProviderConfig *provider_config.Config
+
+	nameSuffix string
+}
+
+var _ accessbinding.ResourceIamIdAttributesUpdater = &IAMUpdater{}
+
+func NewIamBinding() resource.Resource {
+	return accessbinding.NewIamBinding(newIAMUpdater("iam_binding"))
+}
+
+func NewIamMember() resource.Resource {
+	return accessbinding.NewIamMember(newIAMUpdater("iam_member"))
+}
+
+func newIAMUpdater(nameSuffix string) accessbinding.ResourceIamUpdater {
+	return &IAMUpdater{nameSuffix: nameSuffix}
+}
+
+func (u *IAMUpdater) client() (accessBindingsClient, error) {
+	newClient, ok := resourceTypes[u.ResourceType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported resource type %q, expected one of: %s", u.ResourceType, strings.Join(ResourceTypes(), ", "))
+	}
+	return newClient(u.ProviderConfig.SDK), nil
+}
+
+func (u *IAMUpdater) GetResourceIamPolicy(ctx context.Context) (*accessbinding.Policy, error) {
+	bindings, err := u.getAccessBindings(ctx, u.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+	return &accessbinding.Policy{Bindings: bindings}, nil
+}
+
+func (u *IAMUpdater) getAccessBindings(ctx context.Context, id string) ([]*access.AccessBinding, error) {
+	client, err := u.client()
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []*access.AccessBinding
+	pageToken := ""
+
+	for {
+		resp, err := client.ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: id,
+			PageSize:   accessbinding.DefaultPageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
+
+func (u *IAMUpdater) SetResourceIamPolicy(ctx context.Context, policy *accessbinding.Policy) error {
+	client, err := u.client()
+	if err != nil {
+		return err
+	}
+
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.ResourceId,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, provider_config.DefaultTimeout)
+	defer cancel()
+
+	op, err := u.ProviderConfig.SDK.WrapOperation(client.SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *IAMUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *accessbinding.PolicyDelta) error {
+	client, err := u.client()
+	if err != nil {
+		return err
+	}
+
+	var (
+		bSize  = 1000
+		deltas = policy.Deltas
+		dLen   = len(deltas)
+	)
+
+	for i := 0; i < accessbinding.CountBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.ResourceId,
+			AccessBindingDeltas: deltas[i*bSize : min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.ProviderConfig.SDK.WrapOperation(client.UpdateAccessBindings(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *IAMUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-%s-%s", strings.ReplaceAll(u.ResourceType, ".", "-"), u.ResourceId)
+}
+
+func (u *IAMUpdater) DescribeResource() string {
+	return fmt.Sprintf("%s '%s'", u.ResourceType, u.ResourceId)
+}
+
+func (u *IAMUpdater) GetNameSuffix() string {
+	return u.nameSuffix
+}
+
+func (u *IAMUpdater) GetSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"resource_type": schema.StringAttribute{
+			MarkdownDescription: "The type of the resource to attach the policy to, e.g. `logging.group`. " +
+				"One of: " + "`" + strings.Join(ResourceTypes(), "`, `") + "`.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(ResourceTypes()...),
+			},
+		},
+		u.GetIdAlias(): schema.StringAttribute{
+			MarkdownDescription: "The ID of the resource to attach the policy to.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (u *IAMUpdater) GetIdAlias() string {
+	return "resource_id"
+}
+
+func (u *IAMUpdater) GetIdAttributes() []string {
+	return []string{"resource_type", u.GetIdAlias()}
+}
+
+func (u *IAMUpdater) GetId() string {
+	return u.ResourceId
+}
+
+func (u *IAMUpdater) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. "+
+				"Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	u.ProviderConfig = providerConfig
+}
+
+func (u *IAMUpdater) Initialize(ctx context.Context, state accessbinding.Extractable, diag *diag.Diagnostics) {
+	var resourceType, id types.String
+
+	diag.Append(state.GetAttribute(ctx, path.Root("resource_type"), &resourceType)...)
+	diag.Append(state.GetAttribute(ctx, path.Root(u.GetIdAlias()), &id)...)
+	u.ResourceType = resourceType.ValueString()
+	u.ResourceId = id.ValueString()
+}
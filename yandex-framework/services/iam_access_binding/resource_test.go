@@ -0,0 +1,174 @@
+package iam_access_binding_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
+	"google.golang.org/protobuf/proto"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	timeout = 15 * time.Minute
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testCheckFakeCloudMembers(server *fakecloud.Server, resourceID, role string, members ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var got []string
+		for _, b := range server.AccessBindings(resourceID) {
+			if b.RoleId == role {
+				got = append(got, b.Subject.Type+":"+b.Subject.Id)
+			}
+		}
+		sort.Strings(got)
+		sort.Strings(members)
+		if fmt.Sprint(got) != fmt.Sprint(members) {
+			return fmt.Errorf("expected members of %q to be %v, got %v", role, members, got)
+		}
+		return nil
+	}
+}
+
+func TestUnitIamMemberAndBinding_fakeCloud(t *testing.T) {
+	fakecloud.SkipWithoutTerraform(t)
+	fakecloud.IsolateEnv(t)
+
+	server := fakecloud.New(t)
+	config := server.ProviderConfig() + fmt.Sprintf(`
+resource "yandex_iam_member" "viewer" {
+  resource_type = "resource-manager.folder"
+  resource_id   = "%[1]s"
+  role          = "viewer"
+  member        = "serviceAccount:sa1"
+}
+
+resource "yandex_iam_binding" "editor" {
+  resource_type = "resource-manager.folder"
+  resource_id   = "%[1]s"
+  role          = "editor"
+  members       = ["serviceAccount:sa2", "userAccount:user1"]
+}
+`, fakecloud.FolderID)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckFakeCloudMembers(server, fakecloud.FolderID, "viewer"),
+			testCheckFakeCloudMembers(server, fakecloud.FolderID, "editor"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeCloudMembers(server, fakecloud.FolderID, "viewer", "serviceAccount:sa1"),
+					testCheckFakeCloudMembers(server, fakecloud.FolderID, "editor", "serviceAccount:sa2", "userAccount:user1"),
+					resource.TestCheckResourceAttr("yandex_iam_member.viewer", "resource_type", "resource-manager.folder"),
+					resource.TestCheckResourceAttr("yandex_iam_binding.editor", "members.#", "2"),
+				),
+			},
+			{
+				ResourceName:                         "yandex_iam_member.viewer",
+				ImportState:                          true,
+				ImportStateId:                        "resource-manager.folder," + fakecloud.FolderID + ",viewer,serviceAccount:sa1",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_id",
+			},
+			{
+				ResourceName:                         "yandex_iam_binding.editor",
+				ImportState:                          true,
+				ImportStateId:                        "resource-manager.folder," + fakecloud.FolderID + ",editor",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_id",
+			},
+		},
+	})
+}
+
+func TestAccIamMemberAndBinding_loggingGroup(t *testing.T) {
+	var (
+		group       logging.LogGroup
+		groupName   = acctest.RandomWithPrefix(test.TestPrefix())
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	)
+	defer cancel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingGroupIamConfig(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingGroupExists("yandex_logging_group.test", &group),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Logging().LogGroup()
+					}, &group, "logging.viewer", []string{"system:allAuthenticatedUsers"}),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Logging().LogGroup()
+					}, &group, "logging.writer", []string{"system:allAuthenticatedUsers"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLoggingGroupExists(name string, group *logging.LogGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		found, err := config.SDK.Logging().LogGroup().Get(context.Background(), &logging.GetLogGroupRequest{
+			LogGroupId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		proto.Reset(group)
+		proto.Merge(group, found)
+		return nil
+	}
+}
+
+func testAccLoggingGroupIamConfig(groupName string) string {
+	return fmt.Sprintf(`
+resource "yandex_logging_group" "test" {
+  name = "%s"
+}
+
+resource "yandex_iam_member" "test" {
+  resource_type = "logging.group"
+  resource_id   = yandex_logging_group.test.id
+  role          = "logging.viewer"
+  member        = "system:allAuthenticatedUsers"
+}
+
+resource "yandex_iam_binding" "test" {
+  resource_type = "logging.group"
+  resource_id   = yandex_logging_group.test.id
+  role          = "logging.writer"
+  members       = ["system:allAuthenticatedUsers"]
+}
+`, groupName)
+}
@@ -0,0 +1,83 @@
+package iam_access_binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+func newFakeCloudConfig(t *testing.T) (*provider_config.Config, *fakecloud.Server) {
+	server := fakecloud.New(t)
+
+	c := &provider_config.Config{
+		ProviderState: provider_config.State{
+			Endpoint:   types.StringValue(server.Endpoint()),
+			Plaintext:  types.BoolValue(true),
+			Token:      types.StringValue(fakecloud.Token),
+			MaxRetries: types.Int64Value(1),
+		},
+	}
+	require.NoError(t, c.InitAndValidate(context.Background(), "1.10.0", false))
+	return c, server
+}
+
+func binding(role, member string) *access.AccessBinding {
+	return &access.AccessBinding{
+		RoleId:  role,
+		Subject: &access.Subject{Type: "serviceAccount", Id: member},
+	}
+}
+
+func TestIAMUpdater(t *testing.T) {
+	ctx := context.Background()
+	config, server := newFakeCloudConfig(t)
+
+	u := &IAMUpdater{ResourceType: "resource-manager.folder", ResourceId: fakecloud.FolderID, ProviderConfig: config}
+	assert.Equal(t, "iam-resource-manager-folder-"+fakecloud.FolderID, u.GetMutexKey())
+
+	err := u.UpdateResourceIamPolicy(ctx, &accessbinding.PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{Action: access.AccessBindingAction_ADD, AccessBinding: binding("viewer", "sa1")},
+			{Action: access.AccessBindingAction_ADD, AccessBinding: binding("viewer", "sa2")},
+		},
+	})
+	require.NoError(t, err)
+
+	policy, err := u.GetResourceIamPolicy(ctx)
+	require.NoError(t, err)
+	assert.Len(t, policy.Bindings, 2)
+
+	err = u.SetResourceIamPolicy(ctx, &accessbinding.Policy{Bindings: []*access.AccessBinding{binding("editor", "sa3")}})
+	require.NoError(t, err)
+
+	bindings := server.AccessBindings(fakecloud.FolderID)
+	require.Len(t, bindings, 1)
+	assert.Equal(t, "editor", bindings[0].RoleId)
+	assert.Equal(t, "sa3", bindings[0].Subject.Id)
+
+	u.ResourceType, u.ResourceId = "compute.disk", "fhmmissing"
+	_, err = u.GetResourceIamPolicy(ctx)
+	assert.True(t, validate.IsStatusWithCode(err, codes.NotFound), "unexpected error: %v", err)
+
+	u.ResourceType = "vpc.network"
+	_, err = u.GetResourceIamPolicy(ctx)
+	assert.ErrorContains(t, err, `unsupported resource type "vpc.network"`)
+}
+
+func TestResourceTypes(t *testing.T) {
+	types := ResourceTypes()
+	assert.IsIncreasing(t, types)
+	for _, resourceType := range types {
+		assert.Regexp(t, `^[a-z-]+(\.[a-z-]+)+$`, resourceType)
+	}
+}
@@ -0,0 +1,204 @@
+package iam_effective_access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ datasource.DataSource              = &effectiveAccessDataSource{}
+	_ datasource.DataSourceWithConfigure = &effectiveAccessDataSource{}
+)
+
+type effectiveAccessDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewDataSource() datasource.DataSource {
+	return &effectiveAccessDataSource{}
+}
+
+func (d *effectiveAccessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_effective_access"
+}
+
+func bindingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"role": schema.StringAttribute{
+			MarkdownDescription: "The role granted by the binding.",
+			Computed:            true,
+		},
+		"member": schema.StringAttribute{
+			MarkdownDescription: "The subject of the binding in `TYPE:ID` format.",
+			Computed:            true,
+		},
+		"subject_type": schema.StringAttribute{
+			MarkdownDescription: "The type of the subject, e.g. `userAccount`, `serviceAccount`, `group` or `system`.",
+			Computed:            true,
+		},
+		"subject_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the subject.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			MarkdownDescription: "The type of the resource the binding is set on: `resource-manager.folder`, `resource-manager.cloud` or `organization-manager.organization`.",
+			Computed:            true,
+		},
+		"resource_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the resource the binding is set on.",
+			Computed:            true,
+		},
+		"inherited": schema.BoolAttribute{
+			MarkdownDescription: "Whether the binding is inherited from a parent cloud or organization.",
+			Computed:            true,
+		},
+		"covered_subject_types": schema.ListAttribute{
+			MarkdownDescription: "The types of subjects granted access by the binding, e.g. `system:allAuthenticatedUsers` covers `federatedUser`, `serviceAccount` and `userAccount`.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+func (d *effectiveAccessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists access bindings, which apply to a folder, cloud or organization, including the ones inherited from its parents, and reports the bindings, which differ from the expected ones. The data source never modifies access bindings and can be used to detect drift of IAM policies.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the folder to list access bindings of.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cloud_id"), path.MatchRoot("organization_id")),
+				},
+			},
+			"cloud_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cloud to list access bindings of.",
+				Optional:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization to list access bindings of.",
+				Optional:            true,
+			},
+			"include_inherited": schema.BoolAttribute{
+				MarkdownDescription: "Whether to list access bindings inherited from the parent cloud and organization. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"expected_bindings": schema.ListNestedAttribute{
+				MarkdownDescription: "The desired access bindings. If set, `unexpected_bindings` and `missing_bindings` are computed against it.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role, which is expected to be granted.",
+							Required:            true,
+						},
+						"members": schema.SetAttribute{
+							MarkdownDescription: "The members in `TYPE:ID` format, which are expected to be granted the `role`.",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+					},
+				},
+			},
+			"bindings": schema.ListNestedAttribute{
+				MarkdownDescription: "All access bindings, which apply to the resource.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: bindingAttributes(),
+				},
+			},
+			"unexpected_bindings": schema.ListNestedAttribute{
+				MarkdownDescription: "The access bindings, which are not listed in `expected_bindings`. Empty, if `expected_bindings` is not set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: bindingAttributes(),
+				},
+			},
+			"missing_bindings": schema.ListNestedAttribute{
+				MarkdownDescription: "The role and member pairs listed in `expected_bindings`, which are not granted.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The expected role.",
+							Computed:            true,
+						},
+						"member": schema.StringAttribute{
+							MarkdownDescription: "The expected member.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *effectiveAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state effectiveAccessModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var resource resourceRef
+	switch {
+	case !state.FolderID.IsNull():
+		resource = resourceRef{Type: resourceTypeFolder, ID: state.FolderID.ValueString()}
+	case !state.CloudID.IsNull():
+		resource = resourceRef{Type: resourceTypeCloud, ID: state.CloudID.ValueString()}
+	default:
+		resource = resourceRef{Type: resourceTypeOrganization, ID: state.OrganizationID.ValueString()}
+	}
+
+	if state.IncludeInherited.IsNull() {
+		state.IncludeInherited = types.BoolValue(true)
+	}
+
+	bindings, err := effectiveAccess(ctx, d.providerConfig.SDK, resource, state.IncludeInherited.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Access Bindings", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(resource.Type + "/" + resource.ID)
+	state.Bindings = flattenBindings(bindings)
+
+	unexpected, missing := []effectiveBinding(nil), [][2]string(nil)
+	if state.ExpectedBindings != nil {
+		unexpected, missing = diffBindings(bindings, expandExpectedBindings(state.ExpectedBindings))
+	}
+	state.UnexpectedBindings = flattenBindings(unexpected)
+	state.MissingBindings = flattenMissingBindings(missing)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *effectiveAccessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
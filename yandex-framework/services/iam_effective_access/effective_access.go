@@ -0,0 +1,216 @@
+package iam_effective_access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
+)
+
+const (
+	resourceTypeOrganization = "organization-manager.organization"
+	resourceTypeCloud        = "resource-manager.cloud"
+	resourceTypeFolder       = "resource-manager.folder"
+)
+
+// Subject types, which access bindings are granted to.
+const (
+	subjectTypeAnonymous      = "anonymous"
+	subjectTypeFederatedUser  = "federatedUser"
+	subjectTypeGroup          = "group"
+	subjectTypeServiceAccount = "serviceAccount"
+	subjectTypeSystem         = "system"
+	subjectTypeUserAccount    = "userAccount"
+)
+
+type listAccessBindingsFunc func(ctx context.Context, in *access.ListAccessBindingsRequest, opts ...grpc.CallOption) (*access.ListAccessBindingsResponse, error)
+
+// resourceRef is a resource of the resource hierarchy, which has access bindings.
+type resourceRef struct {
+	Type string
+	ID   string
+}
+
+// effectiveBinding is an access binding of a single member, which applies to the requested resource.
+type effectiveBinding struct {
+	Role                string
+	Member              string
+	SubjectType         string
+	SubjectID           string
+	ResourceType        string
+	ResourceID          string
+	Inherited           bool
+	CoveredSubjectTypes []string
+}
+
+// expectedBinding is a role, which is expected to be granted to the members.
+type expectedBinding struct {
+	Role    string
+	Members []string
+}
+
+// resourceHierarchy returns the resource followed by its ancestors, which it inherits access bindings from:
+// a folder inherits access bindings of its cloud and a cloud inherits access bindings of its organization.
+func resourceHierarchy(ctx context.Context, sdk *ycsdk.SDK, resource resourceRef) ([]resourceRef, error) {
+	hierarchy := []resourceRef{resource}
+
+	cloudID := ""
+	switch resource.Type {
+	case resourceTypeFolder:
+		folder, err := sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{FolderId: resource.ID})
+		if err != nil {
+			return nil, fmt.Errorf("error getting folder %q: %w", resource.ID, err)
+		}
+		cloudID = folder.CloudId
+		hierarchy = append(hierarchy, resourceRef{Type: resourceTypeCloud, ID: cloudID})
+	case resourceTypeCloud:
+		cloudID = resource.ID
+	}
+
+	if cloudID != "" {
+		cloud, err := sdk.ResourceManager().Cloud().Get(ctx, &resourcemanager.GetCloudRequest{CloudId: cloudID})
+		if err != nil {
+			return nil, fmt.Errorf("error getting cloud %q: %w", cloudID, err)
+		}
+		if cloud.OrganizationId != "" {
+			hierarchy = append(hierarchy, resourceRef{Type: resourceTypeOrganization, ID: cloud.OrganizationId})
+		}
+	}
+
+	return hierarchy, nil
+}
+
+func listAccessBindings(sdk *ycsdk.SDK, resourceType string) listAccessBindingsFunc {
+	switch resourceType {
+	case resourceTypeOrganization:
+		return sdk.OrganizationManager().Organization().ListAccessBindings
+	case resourceTypeCloud:
+		return sdk.ResourceManager().Cloud().ListAccessBindings
+	default:
+		return sdk.ResourceManager().Folder().ListAccessBindings
+	}
+}
+
+func getAccessBindings(ctx context.Context, list listAccessBindingsFunc, id string) ([]*access.AccessBinding, error) {
+	var bindings []*access.AccessBinding
+	pageToken := ""
+
+	for {
+		resp, err := list(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: id,
+			PageSize:   accessbinding.DefaultPageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
+
+// effectiveAccess lists access bindings of the resource and, if includeInherited is set, of its ancestors.
+func effectiveAccess(ctx context.Context, sdk *ycsdk.SDK, resource resourceRef, includeInherited bool) ([]effectiveBinding, error) {
+	hierarchy := []resourceRef{resource}
+	if includeInherited {
+		var err error
+		hierarchy, err = resourceHierarchy(ctx, sdk, resource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var result []effectiveBinding
+	for i, r := range hierarchy {
+		bindings, err := getAccessBindings(ctx, listAccessBindings(sdk, r.Type), r.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing access bindings of %s %q: %w", r.Type, r.ID, err)
+		}
+		for _, b := range bindings {
+			result = append(result, newEffectiveBinding(b, r, i > 0))
+		}
+	}
+	return result, nil
+}
+
+func newEffectiveBinding(b *access.AccessBinding, resource resourceRef, inherited bool) effectiveBinding {
+	return effectiveBinding{
+		Role:                b.RoleId,
+		Member:              b.Subject.Type + ":" + b.Subject.Id,
+		SubjectType:         b.Subject.Type,
+		SubjectID:           b.Subject.Id,
+		ResourceType:        resource.Type,
+		ResourceID:          resource.ID,
+		Inherited:           inherited,
+		CoveredSubjectTypes: coveredSubjectTypes(b.Subject),
+	}
+}
+
+// coveredSubjectTypes returns the types of subjects, which are granted access by the binding subject,
+// e.g. `system:allAuthenticatedUsers` grants access to all users and service accounts.
+func coveredSubjectTypes(subject *access.Subject) []string {
+	switch subject.Type {
+	case subjectTypeGroup:
+		return []string{subjectTypeFederatedUser, subjectTypeServiceAccount, subjectTypeUserAccount}
+	case subjectTypeSystem:
+		switch {
+		case subject.Id == "allUsers":
+			return []string{subjectTypeAnonymous, subjectTypeFederatedUser, subjectTypeServiceAccount, subjectTypeUserAccount}
+		case subject.Id == "allAuthenticatedUsers":
+			return []string{subjectTypeFederatedUser, subjectTypeServiceAccount, subjectTypeUserAccount}
+		case strings.HasPrefix(subject.Id, "group:federation:"):
+			return []string{subjectTypeFederatedUser}
+		case strings.HasPrefix(subject.Id, "group:organization:"):
+			return []string{subjectTypeFederatedUser, subjectTypeUserAccount}
+		}
+	}
+	return []string{subject.Type}
+}
+
+// diffBindings returns the bindings, which are not expected, and the expected bindings, which are missing,
+// as pairs of role and member.
+func diffBindings(bindings []effectiveBinding, expected []expectedBinding) (unexpected []effectiveBinding, missing [][2]string) {
+	expectedSet := make(map[[2]string]bool)
+	for _, e := range expected {
+		for _, member := range e.Members {
+			expectedSet[[2]string{e.Role, member}] = true
+		}
+	}
+
+	found := make(map[[2]string]bool)
+	for _, b := range bindings {
+		key := [2]string{b.Role, b.Member}
+		if expectedSet[key] {
+			found[key] = true
+			continue
+		}
+		unexpected = append(unexpected, b)
+	}
+
+	for key := range expectedSet {
+		if !found[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		if missing[i][0] != missing[j][0] {
+			return missing[i][0] < missing[j][0]
+		}
+		return missing[i][1] < missing[j][1]
+	})
+	return unexpected, missing
+}
@@ -0,0 +1,137 @@
+package iam_effective_access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+func newFakeCloudConfig(t *testing.T) *provider_config.Config {
+	server := fakecloud.New(t)
+
+	c := &provider_config.Config{
+		ProviderState: provider_config.State{
+			Endpoint:   types.StringValue(server.Endpoint()),
+			Plaintext:  types.BoolValue(true),
+			Token:      types.StringValue(fakecloud.Token),
+			MaxRetries: types.Int64Value(1),
+		},
+	}
+	require.NoError(t, c.InitAndValidate(context.Background(), "1.10.0", false))
+	return c
+}
+
+func binding(role, subjectType, subjectID string) *access.AccessBinding {
+	return &access.AccessBinding{
+		RoleId:  role,
+		Subject: &access.Subject{Type: subjectType, Id: subjectID},
+	}
+}
+
+func TestEffectiveAccess(t *testing.T) {
+	ctx := context.Background()
+	config := newFakeCloudConfig(t)
+
+	_, err := config.SDK.ResourceManager().Folder().SetAccessBindings(ctx, &access.SetAccessBindingsRequest{
+		ResourceId: fakecloud.FolderID,
+		AccessBindings: []*access.AccessBinding{
+			binding("editor", "serviceAccount", "sa1"),
+			binding("viewer", "system", "allAuthenticatedUsers"),
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = config.SDK.ResourceManager().Cloud().SetAccessBindings(ctx, &access.SetAccessBindingsRequest{
+		ResourceId:     fakecloud.CloudID,
+		AccessBindings: []*access.AccessBinding{binding("admin", "userAccount", "user1")},
+	})
+	require.NoError(t, err)
+
+	folder := resourceRef{Type: resourceTypeFolder, ID: fakecloud.FolderID}
+
+	bindings, err := effectiveAccess(ctx, config.SDK, folder, true)
+	require.NoError(t, err)
+	assert.Equal(t, []effectiveBinding{
+		{
+			Role:                "editor",
+			Member:              "serviceAccount:sa1",
+			SubjectType:         "serviceAccount",
+			SubjectID:           "sa1",
+			ResourceType:        resourceTypeFolder,
+			ResourceID:          fakecloud.FolderID,
+			CoveredSubjectTypes: []string{"serviceAccount"},
+		},
+		{
+			Role:                "viewer",
+			Member:              "system:allAuthenticatedUsers",
+			SubjectType:         "system",
+			SubjectID:           "allAuthenticatedUsers",
+			ResourceType:        resourceTypeFolder,
+			ResourceID:          fakecloud.FolderID,
+			CoveredSubjectTypes: []string{"federatedUser", "serviceAccount", "userAccount"},
+		},
+		{
+			Role:                "admin",
+			Member:              "userAccount:user1",
+			SubjectType:         "userAccount",
+			SubjectID:           "user1",
+			ResourceType:        resourceTypeCloud,
+			ResourceID:          fakecloud.CloudID,
+			Inherited:           true,
+			CoveredSubjectTypes: []string{"userAccount"},
+		},
+	}, bindings)
+
+	bindings, err = effectiveAccess(ctx, config.SDK, folder, false)
+	require.NoError(t, err)
+	assert.Len(t, bindings, 2)
+
+	_, err = effectiveAccess(ctx, config.SDK, resourceRef{Type: resourceTypeFolder, ID: "b1gmissing"}, true)
+	assert.ErrorContains(t, err, `error getting folder "b1gmissing"`)
+}
+
+func TestCoveredSubjectTypes(t *testing.T) {
+	tests := []struct {
+		subject  *access.Subject
+		expected []string
+	}{
+		{&access.Subject{Type: "userAccount", Id: "u1"}, []string{"userAccount"}},
+		{&access.Subject{Type: "group", Id: "g1"}, []string{"federatedUser", "serviceAccount", "userAccount"}},
+		{&access.Subject{Type: "system", Id: "allUsers"}, []string{"anonymous", "federatedUser", "serviceAccount", "userAccount"}},
+		{&access.Subject{Type: "system", Id: "group:federation:fed1:users"}, []string{"federatedUser"}},
+		{&access.Subject{Type: "system", Id: "group:organization:org1:users"}, []string{"federatedUser", "userAccount"}},
+		{&access.Subject{Type: "system", Id: "unknown"}, []string{"system"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.subject.Type+":"+tt.subject.Id, func(t *testing.T) {
+			assert.Equal(t, tt.expected, coveredSubjectTypes(tt.subject))
+		})
+	}
+}
+
+func TestDiffBindings(t *testing.T) {
+	bindings := []effectiveBinding{
+		{Role: "editor", Member: "serviceAccount:sa1"},
+		{Role: "viewer", Member: "serviceAccount:sa1"},
+		{Role: "viewer", Member: "system:allUsers", Inherited: true},
+	}
+
+	unexpected, missing := diffBindings(bindings, []expectedBinding{
+		{Role: "editor", Members: []string{"serviceAccount:sa1", "userAccount:u1"}},
+		{Role: "admin", Members: []string{"group:g1"}},
+	})
+	assert.Equal(t, bindings[1:], unexpected)
+	assert.Equal(t, [][2]string{{"admin", "group:g1"}, {"editor", "userAccount:u1"}}, missing)
+
+	unexpected, missing = diffBindings(bindings, nil)
+	assert.Equal(t, bindings, unexpected)
+	assert.Empty(t, missing)
+}
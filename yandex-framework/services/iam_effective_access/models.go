@@ -0,0 +1,77 @@
+package iam_effective_access
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type effectiveAccessModel struct {
+	ID                 types.String           `tfsdk:"id"`
+	FolderID           types.String           `tfsdk:"folder_id"`
+	CloudID            types.String           `tfsdk:"cloud_id"`
+	OrganizationID     types.String           `tfsdk:"organization_id"`
+	IncludeInherited   types.Bool             `tfsdk:"include_inherited"`
+	ExpectedBindings   []expectedBindingModel `tfsdk:"expected_bindings"`
+	Bindings           []bindingModel         `tfsdk:"bindings"`
+	UnexpectedBindings []bindingModel         `tfsdk:"unexpected_bindings"`
+	MissingBindings    []missingBindingModel  `tfsdk:"missing_bindings"`
+}
+
+type expectedBindingModel struct {
+	Role    types.String `tfsdk:"role"`
+	Members []string     `tfsdk:"members"`
+}
+
+type bindingModel struct {
+	Role                types.String `tfsdk:"role"`
+	Member              types.String `tfsdk:"member"`
+	SubjectType         types.String `tfsdk:"subject_type"`
+	SubjectID           types.String `tfsdk:"subject_id"`
+	ResourceType        types.String `tfsdk:"resource_type"`
+	ResourceID          types.String `tfsdk:"resource_id"`
+	Inherited           types.Bool   `tfsdk:"inherited"`
+	CoveredSubjectTypes []string     `tfsdk:"covered_subject_types"`
+}
+
+type missingBindingModel struct {
+	Role   types.String `tfsdk:"role"`
+	Member types.String `tfsdk:"member"`
+}
+
+func flattenBindings(bindings []effectiveBinding) []bindingModel {
+	result := make([]bindingModel, 0, len(bindings))
+	for _, b := range bindings {
+		result = append(result, bindingModel{
+			Role:                types.StringValue(b.Role),
+			Member:              types.StringValue(b.Member),
+			SubjectType:         types.StringValue(b.SubjectType),
+			SubjectID:           types.StringValue(b.SubjectID),
+			ResourceType:        types.StringValue(b.ResourceType),
+			ResourceID:          types.StringValue(b.ResourceID),
+			Inherited:           types.BoolValue(b.Inherited),
+			CoveredSubjectTypes: b.CoveredSubjectTypes,
+		})
+	}
+	return result
+}
+
+func flattenMissingBindings(missing [][2]string) []missingBindingModel {
+	result := make([]missingBindingModel, 0, len(missing))
+	for _, m := range missing {
+		result = append(result, missingBindingModel{
+			Role:   types.StringValue(m[0]),
+			Member: types.StringValue(m[1]),
+		})
+	}
+	return result
+}
+
+func expandExpectedBindings(bindings []expectedBindingModel) []expectedBinding {
+	result := make([]expectedBinding, 0, len(bindings))
+	for _, b := range bindings {
+		result = append(result, expectedBinding{
+			Role:    b.Role.ValueString(),
+			Members: b.Members,
+		})
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package iam_token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type iamTokenEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &iamTokenEphemeralResource{}
+}
+
+func (e *iamTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_token"
+}
+
+func (e *iamTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issues a short-lived IAM token. The token is never persisted into the state.",
+		Attributes: map[string]schema.Attribute{
+			"service_account_key_file": schema.StringAttribute{
+				MarkdownDescription: "Contains either a path to or the contents of the Service Account key file in JSON format. " +
+					"If omitted, the token is issued for the credentials configured in the provider.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"iam_token": schema.StringAttribute{
+				MarkdownDescription: "The IAM token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The token expiration timestamp in RFC3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *iamTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model iamTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Issuing IAM token")
+	token, err := e.createIAMToken(ctx, model.ServiceAccountKeyFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to issue IAM token",
+			fmt.Sprintf("An unexpected error occurred while issuing IAM token. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+
+	model.IAMToken = types.StringValue(token.IamToken)
+	model.ExpiresAt = types.StringValue(timestamp.Get(token.ExpiresAt))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+func (e *iamTokenEphemeralResource) createIAMToken(ctx context.Context, keyFileOrContent string) (*iam.CreateIamTokenResponse, error) {
+	if keyFileOrContent == "" {
+		return e.providerConfig.CreateIAMToken(ctx)
+	}
+
+	credentials, err := provider_config.ServiceAccountKeyCredentials(keyFileOrContent)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeable, ok := credentials.(ycsdk.ExchangeableCredentials)
+	if !ok {
+		return nil, fmt.Errorf("credentials type %T can not be exchanged for IAM token", credentials)
+	}
+
+	tokenReq, err := exchangeable.IAMTokenRequest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build IAM token request: %w", err)
+	}
+
+	return e.providerConfig.SDK.IAM().IamToken().Create(ctx, tokenReq)
+}
+
+func (e *iamTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerConfig = providerConfig
+}
@@ -0,0 +1,42 @@
+package iam_token_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+const testEchoResourceName = "echo.test"
+
+func TestAccIAMTokenEphemeralResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccEphemeralProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMTokenEphemeralResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testEchoResourceName, "data.iam_token"),
+					resource.TestCheckResourceAttrSet(testEchoResourceName, "data.expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIAMTokenEphemeralResourceConfig() string {
+	return `
+ephemeral "yandex_iam_token" "test" {}
+
+provider "echo" {
+  data = ephemeral.yandex_iam_token.test
+}
+
+resource "echo" "test" {}
+`
+}
@@ -0,0 +1,11 @@
+package iam_token
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type iamTokenModel struct {
+	ServiceAccountKeyFile types.String `tfsdk:"service_account_key_file"`
+	IAMToken              types.String `tfsdk:"iam_token"`
+	ExpiresAt             types.String `tfsdk:"expires_at"`
+}
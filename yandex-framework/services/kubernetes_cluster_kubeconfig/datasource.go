@@ -0,0 +1,123 @@
+package kubernetes_cluster_kubeconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ datasource.DataSource              = &kubeconfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &kubeconfigDataSource{}
+)
+
+type kubeconfigDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewDataSource() datasource.DataSource {
+	return &kubeconfigDataSource{}
+}
+
+func (d *kubeconfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster_kubeconfig"
+}
+
+func (d *kubeconfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders a kubeconfig and short-lived credentials for a Managed Service for Kubernetes cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Kubernetes cluster.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cluster_name")),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Kubernetes cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "The folder to look up the cluster by `cluster_name` in. If omitted, the provider folder is used.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"endpoint_type": schema.StringAttribute{
+				MarkdownDescription: "The master endpoint to connect to: `external` (default) or `internal`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(endpointTypes...),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Kubernetes API server.",
+				Computed:            true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded CA certificate of the cluster.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The IAM token to authenticate to the Kubernetes API server with.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The token expiration timestamp in RFC3339 format.",
+				Computed:            true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				MarkdownDescription: "The rendered kubeconfig in YAML format.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *kubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state kubeconfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readKubeconfig(ctx, d.providerConfig, &state.kubeconfigModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(state.ClusterID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *kubeconfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
@@ -0,0 +1,94 @@
+package kubernetes_cluster_kubeconfig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+const testDataSourceName = "data.yandex_kubernetes_cluster_kubeconfig.test"
+
+func TestAccDataSourceKubernetesClusterKubeconfig_basic(t *testing.T) {
+	clusterName := acctest.RandomWithPrefix(test.TestPrefix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterConfig(clusterName) + `
+data "yandex_kubernetes_cluster_kubeconfig" "test" {
+  cluster_name = yandex_kubernetes_cluster.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testDataSourceName, "cluster_id", "yandex_kubernetes_cluster.test", "id"),
+					resource.TestCheckResourceAttrPair(testDataSourceName, "host", "yandex_kubernetes_cluster.test", "master.0.external_v4_endpoint"),
+					resource.TestCheckResourceAttrPair(testDataSourceName, "cluster_ca_certificate", "yandex_kubernetes_cluster.test", "master.0.cluster_ca_certificate"),
+					resource.TestCheckResourceAttr(testDataSourceName, "endpoint_type", "external"),
+					resource.TestCheckResourceAttrSet(testDataSourceName, "token"),
+					resource.TestCheckResourceAttrSet(testDataSourceName, "expires_at"),
+					resource.TestCheckResourceAttrSet(testDataSourceName, "kubeconfig"),
+				),
+			},
+			{
+				Config: testAccKubernetesClusterConfig(clusterName) + `
+data "yandex_kubernetes_cluster_kubeconfig" "test" {
+  cluster_id    = yandex_kubernetes_cluster.test.id
+  endpoint_type = "internal"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testDataSourceName, "cluster_name", "yandex_kubernetes_cluster.test", "name"),
+					resource.TestCheckResourceAttrPair(testDataSourceName, "host", "yandex_kubernetes_cluster.test", "master.0.internal_v4_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesClusterConfig(clusterName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "test" {
+  name = "%[1]s"
+}
+
+resource "yandex_vpc_subnet" "test" {
+  name           = "%[1]s"
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.test.id
+  v4_cidr_blocks = ["192.168.10.0/24"]
+}
+
+resource "yandex_iam_service_account" "test" {
+  name = "%[1]s"
+}
+
+resource "yandex_resourcemanager_folder_iam_member" "test" {
+  folder_id = "%[2]s"
+  role      = "editor"
+  member    = "serviceAccount:${yandex_iam_service_account.test.id}"
+}
+
+resource "yandex_kubernetes_cluster" "test" {
+  name       = "%[1]s"
+  network_id = yandex_vpc_network.test.id
+
+  master {
+    zonal {
+      zone      = yandex_vpc_subnet.test.zone
+      subnet_id = yandex_vpc_subnet.test.id
+    }
+    public_ip = true
+  }
+
+  service_account_id      = yandex_iam_service_account.test.id
+  node_service_account_id = yandex_iam_service_account.test.id
+
+  depends_on = [yandex_resourcemanager_folder_iam_member.test]
+}
+`, clusterName, test.GetExampleFolderID())
+}
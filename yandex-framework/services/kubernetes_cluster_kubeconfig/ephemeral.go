@@ -0,0 +1,114 @@
+package kubernetes_cluster_kubeconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type kubeconfigEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &kubeconfigEphemeralResource{}
+}
+
+func (e *kubeconfigEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster_kubeconfig"
+}
+
+func (e *kubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders a kubeconfig and short-lived credentials for a Managed Service for Kubernetes cluster. " +
+			"The credentials are never persisted into the state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Kubernetes cluster.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cluster_name")),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Kubernetes cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "The folder to look up the cluster by `cluster_name` in. If omitted, the provider folder is used.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"endpoint_type": schema.StringAttribute{
+				MarkdownDescription: "The master endpoint to connect to: `external` (default) or `internal`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(endpointTypes...),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Kubernetes API server.",
+				Computed:            true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded CA certificate of the cluster.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The IAM token to authenticate to the Kubernetes API server with.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The token expiration timestamp in RFC3339 format.",
+				Computed:            true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				MarkdownDescription: "The rendered kubeconfig in YAML format.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *kubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model kubeconfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readKubeconfig(ctx, e.providerConfig, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+func (e *kubeconfigEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerConfig = providerConfig
+}
@@ -0,0 +1,45 @@
+package kubernetes_cluster_kubeconfig_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+const testEchoResourceName = "echo.test"
+
+func TestAccKubernetesClusterKubeconfigEphemeralResource_basic(t *testing.T) {
+	clusterName := acctest.RandomWithPrefix(test.TestPrefix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccEphemeralProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterConfig(clusterName) + `
+ephemeral "yandex_kubernetes_cluster_kubeconfig" "test" {
+  cluster_id = yandex_kubernetes_cluster.test.id
+}
+
+provider "echo" {
+  data = ephemeral.yandex_kubernetes_cluster_kubeconfig.test
+}
+
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testEchoResourceName, "data.cluster_name", "yandex_kubernetes_cluster.test", "name"),
+					resource.TestCheckResourceAttrPair(testEchoResourceName, "data.host", "yandex_kubernetes_cluster.test", "master.0.external_v4_endpoint"),
+					resource.TestCheckResourceAttrSet(testEchoResourceName, "data.token"),
+					resource.TestCheckResourceAttrSet(testEchoResourceName, "data.kubeconfig"),
+				),
+			},
+		},
+	})
+}
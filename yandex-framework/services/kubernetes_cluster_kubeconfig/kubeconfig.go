@@ -0,0 +1,180 @@
+package kubernetes_cluster_kubeconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/objectid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	endpointTypeExternal = "external"
+	endpointTypeInternal = "internal"
+)
+
+var endpointTypes = []string{endpointTypeExternal, endpointTypeInternal}
+
+// readKubeconfig resolves the cluster, issues an IAM token for the provider
+// credentials and fills the computed attributes of the model.
+func readKubeconfig(ctx context.Context, providerConfig *provider_config.Config, model *kubeconfigModel, diags *diag.Diagnostics) {
+	clusterID := model.ClusterID.ValueString()
+	if clusterID == "" {
+		folderID, d := validate.FolderID(model.FolderID, &providerConfig.ProviderState)
+		diags.Append(d)
+		if diags.HasError() {
+			return
+		}
+
+		clusterID, d = objectid.ResolveByNameAndFolderID(ctx, providerConfig.SDK, folderID, model.ClusterName.ValueString(), sdkresolvers.KubernetesClusterResolver)
+		diags.Append(d)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kubernetes cluster %q", clusterID))
+	cluster, err := providerConfig.SDK.Kubernetes().Cluster().Get(ctx, &k8s.GetClusterRequest{
+		ClusterId: clusterID,
+	})
+	if err != nil {
+		diags.AddError(
+			"Unable to read Kubernetes cluster",
+			fmt.Sprintf("An unexpected error occurred while reading Kubernetes cluster %q. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", clusterID, err),
+		)
+		return
+	}
+
+	endpointType := model.EndpointType.ValueString()
+	if endpointType == "" {
+		endpointType = endpointTypeExternal
+	}
+
+	host := clusterEndpoint(cluster, endpointType)
+	if host == "" {
+		diags.AddError(
+			"Kubernetes cluster endpoint is not available",
+			fmt.Sprintf("Kubernetes cluster %q has no %s endpoint. "+
+				"Use endpoint_type = %q to connect from cloud networks or assign a public IP address to the master.",
+				clusterID, endpointType, endpointTypeInternal),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Issuing IAM token")
+	token, err := providerConfig.CreateIAMToken(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to issue IAM token",
+			fmt.Sprintf("An unexpected error occurred while issuing IAM token. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+
+	caCertificate := cluster.GetMaster().GetMasterAuth().GetClusterCaCertificate()
+	kubeconfig, err := renderKubeconfig(cluster.GetId(), cluster.GetName(), host, caCertificate, token.GetIamToken())
+	if err != nil {
+		diags.AddError(
+			"Unable to render kubeconfig",
+			fmt.Sprintf("An unexpected error occurred while rendering kubeconfig for Kubernetes cluster %q. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: %s", clusterID, err),
+		)
+		return
+	}
+
+	model.ClusterID = types.StringValue(cluster.GetId())
+	model.ClusterName = types.StringValue(cluster.GetName())
+	model.FolderID = types.StringValue(cluster.GetFolderId())
+	model.EndpointType = types.StringValue(endpointType)
+	model.Host = types.StringValue(host)
+	model.ClusterCACertificate = types.StringValue(caCertificate)
+	model.Token = types.StringValue(token.GetIamToken())
+	model.ExpiresAt = types.StringValue(timestamp.Get(token.GetExpiresAt()))
+	model.Kubeconfig = types.StringValue(kubeconfig)
+}
+
+func clusterEndpoint(cluster *k8s.Cluster, endpointType string) string {
+	endpoints := cluster.GetMaster().GetEndpoints()
+	if endpointType == endpointTypeInternal {
+		return endpoints.GetInternalV4Endpoint()
+	}
+	return endpoints.GetExternalV4Endpoint()
+}
+
+type kubeconfigFile struct {
+	APIVersion     string              `yaml:"apiVersion"`
+	Kind           string              `yaml:"kind"`
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Users          []kubeconfigUser    `yaml:"users"`
+	Contexts       []kubeconfigContext `yaml:"contexts"`
+	CurrentContext string              `yaml:"current-context"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Token string `yaml:"token"`
+	} `yaml:"user"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+// renderKubeconfig renders a kubeconfig with a static bearer token, so that it
+// can be used without the yc CLI exec plugin. Entry names follow the ones
+// written by `yc managed-kubernetes cluster get-credentials`.
+func renderKubeconfig(clusterID, clusterName, host, caCertificate, token string) (string, error) {
+	entryName := "yc-managed-k8s-" + clusterID
+	contextName := "yc-" + clusterName
+
+	cluster := kubeconfigCluster{Name: entryName}
+	cluster.Cluster.Server = host
+	cluster.Cluster.CertificateAuthorityData = base64.StdEncoding.EncodeToString([]byte(caCertificate))
+
+	user := kubeconfigUser{Name: entryName}
+	user.User.Token = token
+
+	kubeContext := kubeconfigContext{Name: contextName}
+	kubeContext.Context.Cluster = entryName
+	kubeContext.Context.User = entryName
+
+	out, err := yaml.Marshal(kubeconfigFile{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []kubeconfigCluster{cluster},
+		Users:          []kubeconfigUser{user},
+		Contexts:       []kubeconfigContext{kubeContext},
+		CurrentContext: contextName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
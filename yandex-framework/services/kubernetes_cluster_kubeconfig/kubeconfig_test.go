@@ -0,0 +1,45 @@
+package kubernetes_cluster_kubeconfig
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestRenderKubeconfig(t *testing.T) {
+	const caCertificate = "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n"
+
+	out, err := renderKubeconfig("cat1", "my-cluster", "https://10.0.0.1", caCertificate, "t1.token")
+	if err != nil {
+		t.Fatalf("renderKubeconfig() error = %v", err)
+	}
+
+	var cfg kubeconfigFile
+	if err := yaml.Unmarshal([]byte(out), &cfg); err != nil {
+		t.Fatalf("rendered kubeconfig is not valid YAML: %v\n%s", err, out)
+	}
+
+	if cfg.CurrentContext != "yc-my-cluster" {
+		t.Errorf("current-context = %q, want %q", cfg.CurrentContext, "yc-my-cluster")
+	}
+	if len(cfg.Clusters) != 1 || len(cfg.Users) != 1 || len(cfg.Contexts) != 1 {
+		t.Fatalf("expected exactly one cluster, user and context, got:\n%s", out)
+	}
+
+	cluster := cfg.Clusters[0]
+	if cluster.Name != "yc-managed-k8s-cat1" || cluster.Cluster.Server != "https://10.0.0.1" {
+		t.Errorf("unexpected cluster entry: %+v", cluster)
+	}
+	ca, err := base64.StdEncoding.DecodeString(cluster.Cluster.CertificateAuthorityData)
+	if err != nil || string(ca) != caCertificate {
+		t.Errorf("certificate-authority-data does not decode to the cluster CA: %q, %v", ca, err)
+	}
+
+	if cfg.Users[0].User.Token != "t1.token" {
+		t.Errorf("user token = %q, want %q", cfg.Users[0].User.Token, "t1.token")
+	}
+	if ctx := cfg.Contexts[0].Context; ctx.Cluster != cluster.Name || ctx.User != cfg.Users[0].Name {
+		t.Errorf("context does not reference the rendered cluster and user: %+v", ctx)
+	}
+}
@@ -0,0 +1,23 @@
+package kubernetes_cluster_kubeconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type kubeconfigModel struct {
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	ClusterName          types.String `tfsdk:"cluster_name"`
+	FolderID             types.String `tfsdk:"folder_id"`
+	EndpointType         types.String `tfsdk:"endpoint_type"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+}
+
+type kubeconfigDataSourceModel struct {
+	kubeconfigModel
+
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,112 @@
+package lockbox_secret_version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxpayload"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type secretVersionEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &secretVersionEphemeralResource{}
+}
+
+func (e *secretVersionEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lockbox_secret_version"
+}
+
+func (e *secretVersionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the payload of a Yandex Cloud Lockbox secret version. The payload is never persisted into the state.",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				MarkdownDescription: "The Yandex Cloud Lockbox secret ID.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 50),
+				},
+			},
+			"version_id": schema.StringAttribute{
+				MarkdownDescription: "The Yandex Cloud Lockbox secret version ID. If omitted, the current version of the secret is read.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "List of entries in the Yandex Cloud Lockbox secret version.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the entry.",
+							Computed:            true,
+						},
+						"text_value": schema.StringAttribute{
+							MarkdownDescription: "The text value of the entry.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *secretVersionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model secretVersionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payloadReq := &lockbox.GetPayloadRequest{
+		SecretId:  model.SecretId.ValueString(),
+		VersionId: model.VersionId.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Lockbox secret %q version %q payload", payloadReq.SecretId, payloadReq.VersionId))
+	payload, err := e.providerConfig.SDK.LockboxPayload().Payload().Get(ctx, payloadReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read Lockbox secret version payload",
+			fmt.Sprintf("An unexpected error occurred while reading payload of secret %q. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", payloadReq.SecretId, err),
+		)
+		return
+	}
+
+	model.VersionId = types.StringValue(payload.GetVersionId())
+	model.Entries = lockboxpayload.FlattenEntries(payload.GetEntries())
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+func (e *secretVersionEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerConfig = providerConfig
+}
@@ -0,0 +1,69 @@
+package lockbox_secret_version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+const testEchoResourceName = "echo.test"
+
+func TestAccLockboxSecretVersionEphemeralResource_basic(t *testing.T) {
+	secretName := acctest.RandomWithPrefix(test.TestPrefix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccEphemeralProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLockboxSecretVersionEphemeralResourceConfig(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testEchoResourceName, "data.version_id", "yandex_lockbox_secret_version.test", "id"),
+					resource.TestCheckResourceAttr(testEchoResourceName, "data.entries.#", "2"),
+					resource.TestCheckResourceAttr(testEchoResourceName, "data.entries.0.key", "key1"),
+					resource.TestCheckResourceAttr(testEchoResourceName, "data.entries.0.text_value", "val1"),
+					resource.TestCheckResourceAttr(testEchoResourceName, "data.entries.1.key", "key2"),
+					resource.TestCheckResourceAttr(testEchoResourceName, "data.entries.1.text_value", "val2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLockboxSecretVersionEphemeralResourceConfig(secretName string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "test" {
+  name = "%s"
+}
+
+resource "yandex_lockbox_secret_version" "test" {
+  secret_id = yandex_lockbox_secret.test.id
+  entries {
+    key        = "key1"
+    text_value = "val1"
+  }
+  entries {
+    key        = "key2"
+    text_value = "val2"
+  }
+}
+
+ephemeral "yandex_lockbox_secret_version" "test" {
+  secret_id  = yandex_lockbox_secret.test.id
+  version_id = yandex_lockbox_secret_version.test.id
+}
+
+provider "echo" {
+  data = ephemeral.yandex_lockbox_secret_version.test
+}
+
+resource "echo" "test" {}
+`, secretName)
+}
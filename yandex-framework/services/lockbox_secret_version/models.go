@@ -0,0 +1,13 @@
+package lockbox_secret_version
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxpayload"
+)
+
+type secretVersionModel struct {
+	SecretId  types.String           `tfsdk:"secret_id"`
+	VersionId types.String           `tfsdk:"version_id"`
+	Entries   []lockboxpayload.Entry `tfsdk:"entries"`
+}
@@ -0,0 +1,128 @@
+package logging_group_iam_binding_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
+	"google.golang.org/protobuf/proto"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const timeout = 15 * time.Minute
+
+var groupName = test.GenerateNameForResource(10)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccLoggingGroup_basicIamMember(t *testing.T) {
+	var (
+		group  logging.LogGroup
+		userID = "allAuthenticatedUsers"
+		role   = "logging.reader"
+
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	)
+
+	defer cancel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccLoggingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingGroupWithIAMMember_basic(groupName, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccLoggingGroupExists("yandex_logging_group.foobar", &group),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Logging().LogGroup()
+					}, &group, role, []string{"system:" + userID}),
+				),
+			},
+			{
+				ResourceName:                         "yandex_logging_group_iam_binding.test-binding",
+				ImportStateIdFunc:                    func(*terraform.State) (string, error) { return group.Id + "," + role, nil },
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "log_group_id",
+			},
+		},
+	})
+}
+
+func testAccLoggingGroupExists(n string, group *logging.LogGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		found, err := config.SDK.Logging().LogGroup().Get(context.Background(), &logging.GetLogGroupRequest{
+			LogGroupId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if found.Id != rs.Primary.ID {
+			return fmt.Errorf("Log group not found")
+		}
+
+		proto.Reset(group)
+		proto.Merge(group, found)
+
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccLoggingGroupWithIAMMember_basic(name, role, userID string) string {
+	return fmt.Sprintf(`
+resource "yandex_logging_group" "foobar" {
+  name = "%s"
+}
+
+resource "yandex_logging_group_iam_binding" "test-binding" {
+  role = "%s"
+  members = ["system:%s"]
+  log_group_id = yandex_logging_group.foobar.id
+}
+`, name, role, userID)
+}
+
+func testAccLoggingGroupDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_logging_group" {
+			continue
+		}
+
+		_, err := config.SDK.Logging().LogGroup().Get(context.Background(), &logging.GetLogGroupRequest{
+			LogGroupId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("log group still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package serverless_eventrouter_bus_iam_binding_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/eventrouter/v1"
+	"google.golang.org/protobuf/proto"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const timeout = 15 * time.Minute
+
+var busName = test.GenerateNameForResource(10)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccServerlessEventrouterBus_basicIamMember(t *testing.T) {
+	var (
+		bus    eventrouter.Bus
+		userID = "allAuthenticatedUsers"
+		role   = "serverless.eventrouter.viewer"
+
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	)
+
+	defer cancel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccServerlessEventrouterBusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessEventrouterBusWithIAMMember_basic(busName, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccServerlessEventrouterBusExists("yandex_serverless_eventrouter_bus.foobar", &bus),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Serverless().Eventrouter().Bus()
+					}, &bus, role, []string{"system:" + userID}),
+				),
+			},
+			{
+				ResourceName:                         "yandex_serverless_eventrouter_bus_iam_binding.test-binding",
+				ImportStateIdFunc:                    func(*terraform.State) (string, error) { return bus.Id + "," + role, nil },
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bus_id",
+			},
+		},
+	})
+}
+
+func testAccServerlessEventrouterBusExists(n string, bus *eventrouter.Bus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		found, err := config.SDK.Serverless().Eventrouter().Bus().Get(context.Background(), &eventrouter.GetBusRequest{
+			BusId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if found.Id != rs.Primary.ID {
+			return fmt.Errorf("Bus not found")
+		}
+
+		proto.Reset(bus)
+		proto.Merge(bus, found)
+
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccServerlessEventrouterBusWithIAMMember_basic(name, role, userID string) string {
+	return fmt.Sprintf(`
+resource "yandex_serverless_eventrouter_bus" "foobar" {
+  name = "%s"
+}
+
+resource "yandex_serverless_eventrouter_bus_iam_binding" "test-binding" {
+  role = "%s"
+  members = ["system:%s"]
+  bus_id = yandex_serverless_eventrouter_bus.foobar.id
+}
+`, name, role, userID)
+}
+
+func testAccServerlessEventrouterBusDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_serverless_eventrouter_bus" {
+			continue
+		}
+
+		_, err := config.SDK.Serverless().Eventrouter().Bus().Get(context.Background(), &eventrouter.GetBusRequest{
+			BusId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("bus still exists")
+		}
+	}
+
+	return nil
+}
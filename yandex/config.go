@@ -10,6 +10,7 @@ import (
 	"net"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
@@ -17,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/mitchellh/go-homedir"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/go-sdk/iamkey"
 	"github.com/yandex-cloud/go-sdk/pkg/idempotency"
@@ -25,7 +27,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/credentials"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 )
 
@@ -46,6 +50,13 @@ type Config struct {
 	Zone                           string
 	Token                          string
 	ServiceAccountKeyFileOrContent string
+	TokenCommand                   string
+	TokenFile                      string
+	OIDCToken                      string
+	OIDCTokenFile                  string
+	OIDCServiceAccountID           string
+	OIDCTokenExchangeEndpoint      string
+	ImpersonateServiceAccountID    string
 	Plaintext                      bool
 	Insecure                       bool
 	MaxRetries                     int
@@ -66,6 +77,9 @@ type Config struct {
 	SharedCredentialsFile string
 	Profile               string
 
+	// DefaultLabels are merged into labels of every resource, that has them.
+	DefaultLabels map[string]string
+
 	// contextWithClientTraceID is a context that has client-trace-id in its metadata
 	// It is initialized from stopContext at the same time as ycsdk.SDK
 	contextWithClientTraceID context.Context
@@ -75,6 +89,7 @@ type Config struct {
 	sharedCredentials *SharedCredentials
 	defaultS3Client   *s3.Client
 	iamToken          *iamToken
+	iamTokenMu        sync.Mutex
 }
 
 // this function return context with added client trace id
@@ -99,6 +114,15 @@ func (c *Config) ContextWithClientTraceID(ctx context.Context) context.Context {
 func (c *Config) initAndValidate(stopContext context.Context, terraformVersion string, sweeper bool) error {
 	c.contextWithClientTraceID = requestid.ContextWithClientTraceID(stopContext, uuid.New().String())
 
+	err := c.initSharedCredentials()
+	if err != nil {
+		return err
+	}
+
+	if c.Endpoint == "" {
+		c.Endpoint = common.DefaultEndpoint
+	}
+
 	credentials, err := c.credentials()
 	if err != nil {
 		return err
@@ -142,16 +166,20 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 		return err
 	}
 
-	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig,
+	dialOptions := []grpc.DialOption{
 		grpc.WithUserAgent(c.userAgent),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
 		grpc.WithUnaryInterceptor(interceptorChain),
-		retryOptions)
-	if err != nil {
-		return err
+		retryOptions,
 	}
 
-	err = c.initSharedCredentials()
+	// All calls are made on behalf of the impersonated service account,
+	// its IAM token is issued and refreshed by the SDK using the base credentials.
+	if c.ImpersonateServiceAccountID != "" {
+		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(ycsdk.WithAuthAsServiceAccount(c.ImpersonateServiceAccountID)))
+	}
+
+	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig, dialOptions...)
 	if err != nil {
 		return err
 	}
@@ -170,9 +198,37 @@ func (c *Config) initSharedCredentials() error {
 		return err
 	}
 	c.sharedCredentials = sharedCredentials
+	c.applySharedProfile()
 	return nil
 }
 
+// applySharedProfile fills settings, which are not specified explicitly in the provider configuration
+// or environment variables, from yc CLI profile.
+func (c *Config) applySharedProfile() {
+	profile := c.sharedCredentials.YCProfile
+
+	if c.Endpoint == "" {
+		c.Endpoint = profile.Endpoint
+	}
+	if c.CloudID == "" {
+		c.CloudID = profile.CloudID
+	}
+	if c.FolderID == "" {
+		c.FolderID = profile.FolderID
+	}
+	if c.Zone == "" {
+		c.Zone = profile.Zone
+	}
+
+	if c.Token != "" || c.ServiceAccountKeyFileOrContent != "" || c.TokenCommand != "" || c.TokenFile != "" ||
+		c.OIDCToken != "" || c.OIDCTokenFile != "" {
+		return
+	}
+	c.Token = profile.Token
+	c.ServiceAccountKeyFileOrContent = profile.ServiceAccountKey
+	c.TokenCommand = profile.TokenCommand
+}
+
 func (c *Config) resolveStorageAccessKeys() (string, string) {
 	if c.sharedCredentials == nil || (c.StorageAccessKey != "" && c.StorageSecretKey != "") {
 		return c.StorageAccessKey, c.StorageSecretKey // from 'provider "yandex" {...}' or ENV vars
@@ -186,17 +242,18 @@ func (c *Config) initializeDefaultS3Client(ctx context.Context) (err error) {
 	}
 
 	accessKey, secretKey := c.resolveStorageAccessKeys()
-	iamToken, err := c.getIAMToken(ctx)
-	if err != nil {
-		log.Println("[WARN] Failed to get IAM token for default storage client:", err)
-		iamToken = ""
+	if accessKey != "" && secretKey != "" {
+		c.defaultS3Client, err = s3.NewClient(ctx, accessKey, secretKey, nil, c.StorageEndpoint)
+		return err
 	}
 
-	if (accessKey == "" || secretKey == "") && iamToken == "" {
+	if _, err := c.getIAMToken(ctx); err != nil {
+		log.Println("[WARN] Failed to get IAM token for default storage client:", err)
 		return nil
 	}
 
-	c.defaultS3Client, err = s3.NewClient(ctx, accessKey, secretKey, iamToken, c.StorageEndpoint)
+	// IAM token is requested for every request of the client, so it is refreshed, when the cached one expires.
+	c.defaultS3Client, err = s3.NewClient(ctx, "", "", c.getIAMToken, c.StorageEndpoint)
 	return err
 }
 
@@ -221,21 +278,36 @@ func (c *Config) credentials() (ycsdk.Credentials, error) {
 		return ycsdk.OAuthToken(c.Token), nil
 	}
 
+	if c.TokenCommand != "" {
+		return credentials.NewTokenCommand(c.TokenCommand), nil
+	}
+
+	if c.TokenFile != "" {
+		return credentials.NewTokenFile(c.TokenFile), nil
+	}
+
+	if c.OIDCToken != "" || c.OIDCTokenFile != "" {
+		return credentials.OIDCTokenExchange(c.OIDCToken, c.OIDCTokenFile, c.OIDCServiceAccountID, c.OIDCTokenExchangeEndpoint)
+	}
+
 	if sa := ycsdk.InstanceServiceAccount(); checkServiceAccountAvailable(c.Context(), sa) {
 		return sa, nil
 	}
 
 	return nil, fmt.Errorf(
-		"one of 'token' or 'service_account_key_file' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account",
+		"one of 'token', 'service_account_key_file' or 'oidc_token' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account",
 	)
 }
 
 func (c *Config) getIAMToken(ctx context.Context) (string, error) {
+	c.iamTokenMu.Lock()
+	defer c.iamTokenMu.Unlock()
+
 	if c.iamToken != nil && c.iamToken.IsValid() {
 		return c.iamToken.Token, nil
 	}
 
-	resp, err := c.sdk.CreateIAMToken(ctx)
+	resp, err := c.createIAMToken(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get IAM token: %w", err)
 	}
@@ -250,6 +322,15 @@ func (c *Config) getIAMToken(ctx context.Context) (string, error) {
 	return c.iamToken.Token, nil
 }
 
+// createIAMToken issues IAM token for the provider credentials,
+// or for the impersonated service account if it is configured.
+func (c *Config) createIAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	if c.ImpersonateServiceAccountID != "" {
+		return c.sdk.CreateIAMTokenForServiceAccount(ctx, c.ImpersonateServiceAccountID)
+	}
+	return c.sdk.CreateIAMToken(ctx)
+}
+
 func iamKeyFromJSONContent(content string) (*iamkey.Key, error) {
 	key := &iamkey.Key{}
 	err := json.Unmarshal([]byte(content), key)
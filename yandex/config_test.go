@@ -12,8 +12,10 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
 )
 
 const testConfigToken = "some_special_secured_token"
@@ -63,6 +65,37 @@ func TestConfigInitByServiceAccountKey(t *testing.T) {
 	}
 }
 
+func TestConfigImpersonateServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	server := fakecloud.New(t)
+	saID := server.AddServiceAccount("impersonated")
+
+	config := Config{
+		Endpoint:                    server.Endpoint(),
+		FolderID:                    fakecloud.FolderID,
+		CloudID:                     fakecloud.CloudID,
+		Zone:                        fakecloud.Zone,
+		Token:                       fakecloud.Token,
+		ImpersonateServiceAccountID: saID,
+		Plaintext:                   true,
+		MaxRetries:                  1,
+	}
+
+	err := config.initAndValidate(context.Background(), testTerraformVersion, false)
+	require.NoError(t, err)
+
+	_, err = config.sdk.ResourceManager().Folder().Get(context.Background(), &resourcemanager.GetFolderRequest{
+		FolderId: fakecloud.FolderID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fakecloud.ServiceAccountToken(saID), server.RequestToken(resourcemanager.FolderService_Get_FullMethodName))
+
+	token, err := config.getIAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fakecloud.ServiceAccountToken(saID), token)
+}
+
 func TestConfigUserAgent(t *testing.T) {
 	t.Parallel()
 
@@ -201,3 +234,25 @@ func TestConfigInitDefaultS3Client_PreferAccessKeysFromConfig(t *testing.T) {
 	assert.Equal(t, "access-key", credentials.AccessKeyID)
 	assert.Equal(t, "secret-key", credentials.SecretAccessKey)
 }
+
+func TestConfigInitFromYCProfile(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		FolderID:              testConfigFolder,
+		SharedCredentialsFile: "test-fixtures/yc-config.yaml",
+		Profile:               "prod-profile",
+	}
+
+	err := config.initAndValidate(context.Background(), testTerraformVersion, false)
+	if err != nil {
+		t.Fatalf("failed to initAndValidate config: \"%v\"", err.Error())
+	}
+
+	assert.Equal(t, "some_prod_token", config.Token)
+	assert.Equal(t, "prod-cloud-id", config.CloudID)
+	assert.Equal(t, "ru-central1-b", config.Zone)
+	assert.Equal(t, testConfigEndpoint, config.Endpoint)
+	// explicitly specified settings take precedence over the profile
+	assert.Equal(t, testConfigFolder, config.FolderID)
+}
@@ -36,7 +36,7 @@ func dataSourceYandexClientConfigRead(d *schema.ResourceData, meta interface{})
 	config := meta.(*Config)
 	ctx := config.Context()
 
-	response, err := config.sdk.CreateIAMToken(ctx)
+	response, err := config.createIAMToken(ctx)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,120 @@
+package yandex
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func dataSourceYandexComputeDisks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexComputeDisksRead,
+		Schema: listDataSourceSchema("disks", &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"zone": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"block_size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"image_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"snapshot_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_ids": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"labels": {
+					Type:     schema.TypeMap,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"created_at": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceYandexComputeDisksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	disks := []map[string]interface{}{}
+
+	it := config.sdk.Compute().Disk().DiskIterator(ctx, &compute.ListDisksRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		disk := it.Value()
+		if !f.matchLabels(disk.Labels) {
+			continue
+		}
+
+		ids = append(ids, disk.Id)
+		disks = append(disks, map[string]interface{}{
+			"id":           disk.Id,
+			"name":         disk.Name,
+			"description":  disk.Description,
+			"zone":         disk.ZoneId,
+			"type":         disk.TypeId,
+			"size":         toGigabytes(disk.Size),
+			"block_size":   int(disk.BlockSize),
+			"status":       strings.ToLower(disk.Status.String()),
+			"image_id":     disk.GetSourceImageId(),
+			"snapshot_id":  disk.GetSourceSnapshotId(),
+			"instance_ids": disk.InstanceIds,
+			"labels":       disk.Labels,
+			"created_at":   getTimestamp(disk.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing disks in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "disks", ids, disks)
+}
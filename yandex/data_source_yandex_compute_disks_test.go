@@ -0,0 +1,81 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeDisks_byLabels(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-test")
+	label := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeDisksConfig(prefix, label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_disks.by_labels", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_compute_disks.by_labels", "disks.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_compute_disks.by_labels", "disks.0.labels.backup", "true"),
+					resource.TestCheckResourceAttr("data.yandex_compute_disks.by_labels", "disks.0.size", "4"),
+					resource.TestCheckResourceAttrSet("data.yandex_compute_disks.by_labels", "folder_id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_disks.by_filter", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_disks.by_filter", "ids.0",
+						"yandex_compute_disk.other", "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_disks.by_filter", "disks.0.type", "network-hdd"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_disks.by_filter", "disks.0.created_at",
+						"yandex_compute_disk.other", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeDisksConfig(prefix, label string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_disk" "backup" {
+  count = 2
+  name  = "%[1]s-backup-${count.index}"
+  size  = 4
+  type  = "network-hdd"
+
+  labels = {
+    backup = "true"
+    test   = "%[2]s"
+  }
+}
+
+resource "yandex_compute_disk" "other" {
+  name = "%[1]s-other"
+  size = 4
+  type = "network-hdd"
+
+  labels = {
+    test = "%[2]s"
+  }
+}
+
+data "yandex_compute_disks" "by_labels" {
+  labels = {
+    backup = "true"
+    test   = "%[2]s"
+  }
+
+  depends_on = [yandex_compute_disk.backup, yandex_compute_disk.other]
+}
+
+data "yandex_compute_disks" "by_filter" {
+  filter = "name = \"%[1]s-other\""
+
+  depends_on = [yandex_compute_disk.backup, yandex_compute_disk.other]
+}
+`, prefix, label)
+}
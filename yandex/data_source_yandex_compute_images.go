@@ -0,0 +1,109 @@
+package yandex
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func dataSourceYandexComputeImages() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexComputeImagesRead,
+		Schema: listDataSourceSchema("images", &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"family": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"os_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"min_disk_size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pooled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"labels": {
+					Type:     schema.TypeMap,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"created_at": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceYandexComputeImagesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	images := []map[string]interface{}{}
+
+	it := config.sdk.Compute().Image().ImageIterator(ctx, &compute.ListImagesRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		image := it.Value()
+		if !f.matchLabels(image.Labels) {
+			continue
+		}
+
+		ids = append(ids, image.Id)
+		images = append(images, map[string]interface{}{
+			"id":            image.Id,
+			"name":          image.Name,
+			"description":   image.Description,
+			"family":        image.Family,
+			"os_type":       strings.ToLower(image.GetOs().GetType().String()),
+			"min_disk_size": toGigabytes(image.MinDiskSize),
+			"size":          toGigabytes(image.StorageSize),
+			"status":        strings.ToLower(image.Status.String()),
+			"pooled":        image.Pooled,
+			"labels":        image.Labels,
+			"created_at":    getTimestamp(image.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing images in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "images", ids, images)
+}
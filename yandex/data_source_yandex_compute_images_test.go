@@ -0,0 +1,35 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeImages_standardImages(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeImagesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_images.ubuntu", "folder_id", StandardImagesFolderID),
+					resource.TestCheckResourceAttrSet("data.yandex_compute_images.ubuntu", "ids.0"),
+					resource.TestCheckResourceAttr("data.yandex_compute_images.ubuntu", "images.0.family", "ubuntu-2204-lts"),
+					resource.TestCheckResourceAttr("data.yandex_compute_images.ubuntu", "images.0.os_type", "linux"),
+					resource.TestCheckResourceAttr("data.yandex_compute_images.ubuntu", "images.0.status", "ready"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceComputeImagesConfig = `
+data "yandex_compute_images" "ubuntu" {
+  folder_id = "standard-images"
+  filter    = "family = \"ubuntu-2204-lts\""
+}
+`
@@ -0,0 +1,164 @@
+package yandex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func dataSourceYandexComputeInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexComputeInstancesRead,
+		Schema: listDataSourceSchema("instances", &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"zone": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"platform_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"fqdn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"service_account_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"boot_disk_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"secondary_disk_ids": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"network_interface": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"index": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"subnet_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"ip_address": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"nat_ip_address": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"labels": {
+					Type:     schema.TypeMap,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"created_at": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceYandexComputeInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	instances := []map[string]interface{}{}
+
+	it := config.sdk.Compute().Instance().InstanceIterator(ctx, &compute.ListInstancesRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		instance := it.Value()
+		if !f.matchLabels(instance.Labels) {
+			continue
+		}
+
+		secondaryDiskIDs := make([]string, 0, len(instance.SecondaryDisks))
+		for _, disk := range instance.SecondaryDisks {
+			secondaryDiskIDs = append(secondaryDiskIDs, disk.DiskId)
+		}
+
+		ids = append(ids, instance.Id)
+		instances = append(instances, map[string]interface{}{
+			"id":                 instance.Id,
+			"name":               instance.Name,
+			"description":        instance.Description,
+			"zone":               instance.ZoneId,
+			"platform_id":        instance.PlatformId,
+			"status":             strings.ToLower(instance.Status.String()),
+			"fqdn":               instance.Fqdn,
+			"service_account_id": instance.ServiceAccountId,
+			"boot_disk_id":       instance.GetBootDisk().GetDiskId(),
+			"secondary_disk_ids": secondaryDiskIDs,
+			"network_interface":  flattenInstancesNetworkInterfaces(instance),
+			"labels":             instance.Labels,
+			"created_at":         getTimestamp(instance.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing instances in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "instances", ids, instances)
+}
+
+func flattenInstancesNetworkInterfaces(instance *compute.Instance) []map[string]interface{} {
+	nics := make([]map[string]interface{}, 0, len(instance.NetworkInterfaces))
+	for _, iface := range instance.NetworkInterfaces {
+		nic := map[string]interface{}{
+			"subnet_id": iface.SubnetId,
+		}
+		if index, err := strconv.Atoi(iface.Index); err == nil {
+			nic["index"] = index
+		}
+		if addr := iface.GetPrimaryV4Address(); addr != nil {
+			nic["ip_address"] = addr.Address
+			nic["nat_ip_address"] = addr.GetOneToOneNat().GetAddress()
+		}
+		nics = append(nics, nic)
+	}
+	return nics
+}
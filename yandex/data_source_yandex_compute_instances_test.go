@@ -0,0 +1,95 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeInstances_byLabels(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+	label := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeInstancesConfig(instanceName, label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.source", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_instances.source", "ids.0",
+						instanceResource, "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.source", "instances.0.name", instanceName),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.source", "instances.0.status", "running"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_instances.source", "instances.0.boot_disk_id",
+						instanceResource, "boot_disk.0.disk_id"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_instances.source", "instances.0.network_interface.0.ip_address",
+						instanceResource, "network_interface.0.ip_address"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeInstancesConfig(instance, label string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_instance" "foobar" {
+  name        = "%[1]s"
+  zone        = "ru-central1-a"
+  platform_id = "standard-v2"
+
+  resources {
+    cores  = 2
+    memory = 2
+  }
+
+  boot_disk {
+    initialize_params {
+      size     = 4
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.inst-test-subnet.id}"
+  }
+
+  labels = {
+    test = "%[2]s"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+
+data "yandex_compute_instances" "source" {
+  labels = {
+    test = "%[2]s"
+  }
+
+  depends_on = [yandex_compute_instance.foobar]
+}
+
+data "yandex_compute_instances" "by_filter" {
+  filter = "name = \"%[1]s\""
+
+  depends_on = [yandex_compute_instance.foobar]
+}
+`, instance, label)
+}
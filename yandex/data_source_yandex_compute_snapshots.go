@@ -0,0 +1,99 @@
+package yandex
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func dataSourceYandexComputeSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexComputeSnapshotsRead,
+		Schema: listDataSourceSchema("snapshots", &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_disk_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"disk_size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"storage_size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"labels": {
+					Type:     schema.TypeMap,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"created_at": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceYandexComputeSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	snapshots := []map[string]interface{}{}
+
+	it := config.sdk.Compute().Snapshot().SnapshotIterator(ctx, &compute.ListSnapshotsRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		snapshot := it.Value()
+		if !f.matchLabels(snapshot.Labels) {
+			continue
+		}
+
+		ids = append(ids, snapshot.Id)
+		snapshots = append(snapshots, map[string]interface{}{
+			"id":             snapshot.Id,
+			"name":           snapshot.Name,
+			"description":    snapshot.Description,
+			"source_disk_id": snapshot.SourceDiskId,
+			"disk_size":      toGigabytes(snapshot.DiskSize),
+			"storage_size":   toGigabytes(snapshot.StorageSize),
+			"status":         strings.ToLower(snapshot.Status.String()),
+			"labels":         snapshot.Labels,
+			"created_at":     getTimestamp(snapshot.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing snapshots in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "snapshots", ids, snapshots)
+}
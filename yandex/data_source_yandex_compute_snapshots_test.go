@@ -0,0 +1,66 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeSnapshots_byLabels(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-test")
+	label := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckComputeSnapshotDestroy,
+			testAccCheckComputeDiskDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeSnapshotsConfig(prefix, label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_snapshots.source", "ids.0",
+						"yandex_compute_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_snapshots.source", "snapshots.0.source_disk_id",
+						"yandex_compute_disk.foobar", "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "snapshots.0.disk_size", "4"),
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "snapshots.0.status", "ready"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeSnapshotsConfig(prefix, label string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_disk" "foobar" {
+  name = "%[1]s-disk"
+  size = 4
+  type = "network-hdd"
+}
+
+resource "yandex_compute_snapshot" "foobar" {
+  name           = "%[1]s-snapshot"
+  source_disk_id = yandex_compute_disk.foobar.id
+
+  labels = {
+    test = "%[2]s"
+  }
+}
+
+data "yandex_compute_snapshots" "source" {
+  labels = {
+    test = "%[2]s"
+  }
+
+  depends_on = [yandex_compute_snapshot.foobar]
+}
+`, prefix, label)
+}
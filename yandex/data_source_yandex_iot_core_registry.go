@@ -98,6 +98,15 @@ func flattenIoTSet(vars []string) *schema.Set {
 	return result
 }
 
+// flattenIoTCertificates returns data of certificates of an IoT registry, device or broker as a set.
+func flattenIoTCertificates[C interface{ GetCertificateData() string }](certs []C) *schema.Set {
+	data := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		data = append(data, cert.GetCertificateData())
+	}
+	return flattenIoTSet(data)
+}
+
 func dataSourceYandexIotCoreRegistryRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
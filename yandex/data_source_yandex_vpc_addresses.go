@@ -0,0 +1,101 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func dataSourceYandexVPCAddresses() *schema.Resource {
+	single := dataSourceYandexVPCAddress().Schema
+
+	s := listDataSourceSchema("addresses", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_ipv4_address": single["external_ipv4_address"],
+			"reserved":              single["reserved"],
+			"used":                  single["used"],
+			"deletion_protection":   single["deletion_protection"],
+			"dns_record":            single["dns_record"],
+			"labels": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	})
+	s["zone"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceYandexVPCAddressesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceYandexVPCAddressesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config, "zone")
+	if err != nil {
+		return err
+	}
+
+	zone := f.args["zone"]
+
+	ids := []string{}
+	addresses := []map[string]interface{}{}
+
+	it := config.sdk.VPC().Address().AddressIterator(ctx, &vpc.ListAddressesRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		address := it.Value()
+		if zone != "" && address.GetExternalIpv4Address().GetZoneId() != zone {
+			continue
+		}
+		if !f.matchLabels(address.Labels) {
+			continue
+		}
+
+		ids = append(ids, address.Id)
+		addresses = append(addresses, map[string]interface{}{
+			"id":                    address.Id,
+			"name":                  address.Name,
+			"description":           address.Description,
+			"external_ipv4_address": flattenExternalIpV4AddressSpec(address.GetExternalIpv4Address()),
+			"reserved":              address.Reserved,
+			"used":                  address.Used,
+			"deletion_protection":   address.DeletionProtection,
+			"dns_record":            flattenVpcAddressDnsRecords(address.DnsRecords),
+			"labels":                address.Labels,
+			"created_at":            getTimestamp(address.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing addresses in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "addresses", ids, addresses)
+}
@@ -0,0 +1,77 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceVPCAddresses_byZoneAndLabels(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCAddressesConfig(label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_addresses.all", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_addresses.zone_b", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_addresses.zone_b", "ids.0",
+						"yandex_vpc_address.b", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_addresses.zone_b", "addresses.0.external_ipv4_address.0.address",
+						"yandex_vpc_address.b", "external_ipv4_address.0.address"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_addresses.zone_b", "addresses.0.reserved", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPCAddressesConfig(label string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_address" "a" {
+  external_ipv4_address {
+    zone_id = "ru-central1-a"
+  }
+
+  labels = {
+    test = "%[1]s"
+  }
+}
+
+resource "yandex_vpc_address" "b" {
+  external_ipv4_address {
+    zone_id = "ru-central1-b"
+  }
+
+  labels = {
+    test = "%[1]s"
+  }
+}
+
+data "yandex_vpc_addresses" "all" {
+  labels = {
+    test = "%[1]s"
+  }
+
+  depends_on = [yandex_vpc_address.a, yandex_vpc_address.b]
+}
+
+data "yandex_vpc_addresses" "zone_b" {
+  zone = "ru-central1-b"
+
+  labels = {
+    test = "%[1]s"
+  }
+
+  depends_on = [yandex_vpc_address.a, yandex_vpc_address.b]
+}
+`, label)
+}
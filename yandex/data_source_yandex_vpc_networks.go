@@ -0,0 +1,111 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func dataSourceYandexVPCNetworks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexVPCNetworksRead,
+		Schema: listDataSourceSchema("networks", &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"default_security_group_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"subnet_ids": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"labels": {
+					Type:     schema.TypeMap,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"created_at": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceYandexVPCNetworksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	networks := []map[string]interface{}{}
+
+	it := config.sdk.VPC().Network().NetworkIterator(ctx, &vpc.ListNetworksRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		network := it.Value()
+		if !f.matchLabels(network.Labels) {
+			continue
+		}
+
+		subnetIDs, err := listVPCNetworkSubnetIDs(ctx, config, network.Id)
+		if err != nil {
+			return err
+		}
+
+		ids = append(ids, network.Id)
+		networks = append(networks, map[string]interface{}{
+			"id":                        network.Id,
+			"name":                      network.Name,
+			"description":               network.Description,
+			"default_security_group_id": network.DefaultSecurityGroupId,
+			"subnet_ids":                subnetIDs,
+			"labels":                    network.Labels,
+			"created_at":                getTimestamp(network.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing networks in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "networks", ids, networks)
+}
+
+func listVPCNetworkSubnetIDs(ctx context.Context, config *Config, networkID string) ([]string, error) {
+	subnetIDs := []string{}
+
+	it := config.sdk.VPC().Network().NetworkSubnetsIterator(ctx, &vpc.ListNetworkSubnetsRequest{
+		NetworkId: networkID,
+	})
+	for it.Next() {
+		subnetIDs = append(subnetIDs, it.Value().Id)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("Error while listing subnets of network %q: %s", networkID, err)
+	}
+
+	return subnetIDs, nil
+}
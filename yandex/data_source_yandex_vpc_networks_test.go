@@ -0,0 +1,60 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceVPCNetworks_byFilter(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.RandomWithPrefix("tf-network")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckVPCNetworkDestroy,
+			testAccCheckVPCSubnetDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCNetworksConfig(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_networks.source", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_networks.source", "ids.0",
+						"yandex_vpc_network.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_networks.source", "networks.0.name", networkName),
+					resource.TestCheckResourceAttr("data.yandex_vpc_networks.source", "networks.0.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_networks.source", "networks.0.subnet_ids.0",
+						"yandex_vpc_subnet.bar", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_networks.source", "networks.0.default_security_group_id",
+						"yandex_vpc_network.foo", "default_security_group_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPCNetworksConfig(networkName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_subnet" "bar" {
+  network_id     = yandex_vpc_network.foo.id
+  zone           = "ru-central1-a"
+  v4_cidr_blocks = ["172.16.1.0/24"]
+}
+
+data "yandex_vpc_networks" "source" {
+  filter = "name = \"${yandex_vpc_network.foo.name}\""
+
+  depends_on = [yandex_vpc_subnet.bar]
+}
+`, networkName)
+}
@@ -0,0 +1,107 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func dataSourceYandexVPCSecurityGroups() *schema.Resource {
+	single := dataSourceYandexVPCSecurityGroup().Schema
+
+	s := listDataSourceSchema("security_groups", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingress": single["ingress"],
+			"egress":  single["egress"],
+			"labels": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	})
+	s["network_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceYandexVPCSecurityGroupsRead,
+		Schema: s,
+	}
+}
+
+func dataSourceYandexVPCSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config, "network_id")
+	if err != nil {
+		return err
+	}
+
+	networkID := f.args["network_id"]
+
+	ids := []string{}
+	securityGroups := []map[string]interface{}{}
+
+	it := config.sdk.VPC().SecurityGroup().SecurityGroupIterator(ctx, &vpc.ListSecurityGroupsRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		securityGroup := it.Value()
+		if networkID != "" && securityGroup.NetworkId != networkID {
+			continue
+		}
+		if !f.matchLabels(securityGroup.Labels) {
+			continue
+		}
+
+		ingress, egress := flattenSecurityGroupRulesSpec(securityGroup.Rules)
+
+		ids = append(ids, securityGroup.Id)
+		securityGroups = append(securityGroups, map[string]interface{}{
+			"id":          securityGroup.Id,
+			"name":        securityGroup.Name,
+			"description": securityGroup.Description,
+			"network_id":  securityGroup.NetworkId,
+			"status":      securityGroup.Status.String(),
+			"ingress":     ingress,
+			"egress":      egress,
+			"labels":      securityGroup.Labels,
+			"created_at":  getTimestamp(securityGroup.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing security groups in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "security_groups", ids, securityGroups)
+}
@@ -0,0 +1,67 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceVPCSecurityGroups_byNetwork(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.RandomWithPrefix("tf-network")
+	sgName := acctest.RandomWithPrefix("tf-sg")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckVPCSecurityGroupDestroy,
+			testAccCheckVPCNetworkDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCSecurityGroupsConfig(networkName, sgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_groups.source", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_security_groups.source", "ids.0",
+						"yandex_vpc_security_group.sg", "id"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_groups.source", "security_groups.0.name", sgName),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_groups.source", "security_groups.0.ingress.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_groups.source", "security_groups.0.egress.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPCSecurityGroupsConfig(networkName, sgName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "sg" {
+  name       = "%s"
+  network_id = yandex_vpc_network.foo.id
+
+  ingress {
+    protocol       = "TCP"
+    port           = 443
+    v4_cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  egress {
+    protocol       = "ANY"
+    v4_cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+data "yandex_vpc_security_groups" "source" {
+  network_id = yandex_vpc_network.foo.id
+  filter     = "name = \"${yandex_vpc_security_group.sg.name}\""
+}
+`, networkName, sgName)
+}
@@ -0,0 +1,144 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func dataSourceYandexVPCSubnets() *schema.Resource {
+	s := listDataSourceSchema("subnets", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"v4_cidr_blocks": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"v6_cidr_blocks": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"dhcp_options": dataSourceYandexVPCSubnet().Schema["dhcp_options"],
+			"labels": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	})
+	s["network_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		// Subnets of a network are listed without the server-side filter.
+		ConflictsWith: []string{"filter"},
+	}
+	s["zone"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceYandexVPCSubnetsRead,
+		Schema: s,
+	}
+}
+
+func dataSourceYandexVPCSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	f, err := expandListDataSourceFilter(d, config, "network_id", "zone")
+	if err != nil {
+		return err
+	}
+
+	networkID := f.args["network_id"]
+	zone := f.args["zone"]
+
+	ids := []string{}
+	subnets := []map[string]interface{}{}
+
+	addSubnet := func(subnet *vpc.Subnet) {
+		if zone != "" && subnet.ZoneId != zone {
+			return
+		}
+		if !f.matchLabels(subnet.Labels) {
+			return
+		}
+
+		ids = append(ids, subnet.Id)
+		subnets = append(subnets, map[string]interface{}{
+			"id":             subnet.Id,
+			"name":           subnet.Name,
+			"description":    subnet.Description,
+			"network_id":     subnet.NetworkId,
+			"zone":           subnet.ZoneId,
+			"route_table_id": subnet.RouteTableId,
+			"v4_cidr_blocks": subnet.V4CidrBlocks,
+			"v6_cidr_blocks": subnet.V6CidrBlocks,
+			"dhcp_options":   flattenDhcpOptions(subnet.DhcpOptions),
+			"labels":         subnet.Labels,
+			"created_at":     getTimestamp(subnet.CreatedAt),
+		})
+	}
+
+	// Subnets of a network may reside in other folders, e.g. when the network is shared,
+	// so they are listed by the network rather than by the folder.
+	if networkID != "" {
+		it := config.sdk.VPC().Network().NetworkSubnetsIterator(ctx, &vpc.ListNetworkSubnetsRequest{
+			NetworkId: networkID,
+		})
+		for it.Next() {
+			addSubnet(it.Value())
+		}
+		if err := it.Error(); err != nil {
+			return fmt.Errorf("Error while listing subnets of network %q: %s", networkID, err)
+		}
+
+		return setListDataSourceResult(d, f, "subnets", ids, subnets)
+	}
+
+	it := config.sdk.VPC().Subnet().SubnetIterator(ctx, &vpc.ListSubnetsRequest{
+		FolderId: f.folderID,
+		Filter:   f.filter,
+	})
+	for it.Next() {
+		addSubnet(it.Value())
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing subnets in folder %q: %s", f.folderID, err)
+	}
+
+	return setListDataSourceResult(d, f, "subnets", ids, subnets)
+}
@@ -0,0 +1,87 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceVPCSubnets_byNetworkAndZone(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.RandomWithPrefix("tf-network")
+	label := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckVPCNetworkDestroy,
+			testAccCheckVPCSubnetDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCSubnetsConfig(networkName, label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.zone_a", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.zone_a", "subnets.0.zone", "ru-central1-a"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_subnets.zone_a", "subnets.0.network_id",
+						"yandex_vpc_network.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.labelled", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_subnets.labelled", "ids.0",
+						"yandex_vpc_subnet.b", "id"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.labelled", "subnets.0.v4_cidr_blocks.0", "172.16.3.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPCSubnetsConfig(networkName, label string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%[1]s"
+}
+
+resource "yandex_vpc_subnet" "a" {
+  count          = 2
+  network_id     = yandex_vpc_network.foo.id
+  zone           = "ru-central1-a"
+  v4_cidr_blocks = ["172.16.${count.index + 1}.0/24"]
+}
+
+resource "yandex_vpc_subnet" "b" {
+  network_id     = yandex_vpc_network.foo.id
+  zone           = "ru-central1-b"
+  v4_cidr_blocks = ["172.16.3.0/24"]
+
+  labels = {
+    test = "%[2]s"
+  }
+}
+
+data "yandex_vpc_subnets" "all" {
+  network_id = yandex_vpc_network.foo.id
+
+  depends_on = [yandex_vpc_subnet.a, yandex_vpc_subnet.b]
+}
+
+data "yandex_vpc_subnets" "zone_a" {
+  network_id = yandex_vpc_network.foo.id
+  zone       = "ru-central1-a"
+
+  depends_on = [yandex_vpc_subnet.a, yandex_vpc_subnet.b]
+}
+
+data "yandex_vpc_subnets" "labelled" {
+  labels = {
+    test = "%[2]s"
+  }
+
+  depends_on = [yandex_vpc_subnet.a, yandex_vpc_subnet.b]
+}
+`, networkName, label)
+}
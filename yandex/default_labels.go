@@ -0,0 +1,120 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const labelsFieldName = "labels"
+
+// withDefaultLabels merges provider `default_labels` into `labels` of every resource, that has them.
+// Labels of the resource take precedence over default ones. Merged labels are planned by CustomizeDiff,
+// so default labels, that the resource has, are not shown in the plan as drift, and a change of default labels
+// is shown in the plan and is applied to existing resources. Labels are made computed for that,
+// since SDKv2 plans only values of computed attributes.
+func withDefaultLabels(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		labels, ok := r.Schema[labelsFieldName]
+		if !ok || labels.Type != schema.TypeMap {
+			continue
+		}
+
+		customizeDiff := customizeDefaultLabelsDiff(labels.Computed)
+		if r.CustomizeDiff != nil {
+			customizeDiff = customdiff.Sequence(customizeDiff, r.CustomizeDiff)
+		}
+		r.CustomizeDiff = customizeDiff
+		labels.Computed = true
+
+		r.Create = wrapDefaultLabels(r.Create)
+		r.Update = wrapDefaultLabels(r.Update)
+		r.CreateContext = wrapDefaultLabelsContext(r.CreateContext)
+		r.UpdateContext = wrapDefaultLabelsContext(r.UpdateContext)
+		r.CreateWithoutTimeout = wrapDefaultLabelsContext(r.CreateWithoutTimeout)
+		r.UpdateWithoutTimeout = wrapDefaultLabelsContext(r.UpdateWithoutTimeout)
+	}
+}
+
+func wrapDefaultLabels(f crudFunc) crudFunc {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := setDefaultLabels(d, meta.(*Config).DefaultLabels); err != nil {
+			return err
+		}
+		return f(d, meta)
+	}
+}
+
+func wrapDefaultLabelsContext[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := setDefaultLabels(d, meta.(*Config).DefaultLabels); err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// setDefaultLabels makes resource implementation send merged labels to the API.
+func setDefaultLabels(d *schema.ResourceData, defaultLabels map[string]string) error {
+	if len(defaultLabels) == 0 {
+		return nil
+	}
+	return d.Set(labelsFieldName, mergeDefaultLabels(defaultLabels, d.Get(labelsFieldName)))
+}
+
+func mergeDefaultLabels(defaultLabels map[string]string, labels interface{}) map[string]string {
+	merged := make(map[string]string, len(defaultLabels))
+	for k, v := range defaultLabels {
+		merged[k] = v
+	}
+	if m, ok := labels.(map[string]interface{}); ok {
+		for k, v := range m {
+			merged[k] = v.(string)
+		}
+	}
+	return merged
+}
+
+// customizeDefaultLabelsDiff plans configured labels merged with the default ones. Labels, that are not configured,
+// are removed, unless the resource computes them itself. Labels, that are not known yet, are merged on apply.
+func customizeDefaultLabelsDiff(computed bool) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+		configured := config.GetAttr(labelsFieldName)
+		if !configured.IsWhollyKnown() {
+			return nil
+		}
+
+		var defaultLabels map[string]string
+		if c, ok := meta.(*Config); ok {
+			defaultLabels = c.DefaultLabels
+		}
+
+		labels := map[string]interface{}{}
+		switch {
+		case !configured.IsNull():
+			for k, v := range configured.AsValueMap() {
+				if !v.IsNull() {
+					labels[k] = v.AsString()
+				}
+			}
+		case computed:
+			if len(defaultLabels) == 0 {
+				return nil
+			}
+			labels, _ = d.Get(labelsFieldName).(map[string]interface{})
+		}
+		return d.SetNew(labelsFieldName, mergeDefaultLabels(defaultLabels, labels))
+	}
+}
@@ -0,0 +1,242 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func TestMergeDefaultLabels(t *testing.T) {
+	merged := mergeDefaultLabels(
+		map[string]string{"team": "platform", "env": "prod"},
+		map[string]interface{}{"env": "testing", "app": "web"},
+	)
+	assert.Equal(t, map[string]string{"team": "platform", "env": "testing", "app": "web"}, merged)
+}
+
+func testDefaultLabelsResource(computed bool) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: computed,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	withDefaultLabels(map[string]*schema.Resource{"yandex_test": r})
+	return r
+}
+
+func testDefaultLabelsState(labels map[string]string, config cty.Value) *terraform.InstanceState {
+	attributes := map[string]string{"id": "id", "name": "test", "labels.%": strconv.Itoa(len(labels))}
+	for k, v := range labels {
+		attributes["labels."+k] = v
+	}
+	return &terraform.InstanceState{ID: "id", Attributes: attributes, RawConfig: config}
+}
+
+func testDefaultLabelsConfig(labels map[string]interface{}) (*terraform.ResourceConfig, cty.Value) {
+	raw := map[string]interface{}{"name": "test"}
+	labelsValue := cty.NullVal(cty.Map(cty.String))
+	if labels != nil {
+		raw["labels"] = labels
+		values := map[string]cty.Value{}
+		for k, v := range labels {
+			values[k] = cty.StringVal(v.(string))
+		}
+		labelsValue = cty.MapValEmpty(cty.String)
+		if len(values) != 0 {
+			labelsValue = cty.MapVal(values)
+		}
+	}
+	return terraform.NewResourceConfigRaw(raw), cty.ObjectVal(map[string]cty.Value{
+		"name":   cty.StringVal("test"),
+		"labels": labelsValue,
+	})
+}
+
+func TestCustomizeDefaultLabelsDiff(t *testing.T) {
+	defaultLabels := map[string]string{"team": "platform", "env": "prod"}
+
+	cases := []struct {
+		name          string
+		computed      bool
+		defaultLabels map[string]string
+		state         map[string]string
+		config        map[string]interface{}
+		expected      map[string]string
+	}{
+		{
+			name:          "default labels, that the resource has, are not shown as drift",
+			defaultLabels: defaultLabels,
+			state:         map[string]string{"team": "platform", "env": "prod", "app": "web"},
+			config:        map[string]interface{}{"app": "web"},
+		},
+		{
+			name:          "added default label",
+			defaultLabels: map[string]string{"team": "platform", "env": "prod", "owner": "me"},
+			state:         map[string]string{"team": "platform", "env": "prod", "app": "web"},
+			config:        map[string]interface{}{"app": "web"},
+			expected:      map[string]string{"team": "platform", "env": "prod", "owner": "me", "app": "web"},
+		},
+		{
+			name:          "changed default label",
+			defaultLabels: map[string]string{"team": "devops", "env": "prod"},
+			state:         map[string]string{"team": "platform", "env": "prod", "app": "web"},
+			config:        map[string]interface{}{"app": "web"},
+			expected:      map[string]string{"team": "devops", "env": "prod", "app": "web"},
+		},
+		{
+			name:          "removed default label",
+			defaultLabels: map[string]string{"team": "platform"},
+			state:         map[string]string{"team": "platform", "env": "prod", "app": "web"},
+			config:        map[string]interface{}{"app": "web"},
+			expected:      map[string]string{"team": "platform", "app": "web"},
+		},
+		{
+			name:          "configured labels take precedence over defaults",
+			defaultLabels: defaultLabels,
+			state:         map[string]string{"team": "platform", "env": "prod"},
+			config:        map[string]interface{}{"env": "testing"},
+			expected:      map[string]string{"team": "platform", "env": "testing"},
+		},
+		{
+			name:          "labels, that are not configured, are replaced with defaults",
+			defaultLabels: defaultLabels,
+			state:         map[string]string{"team": "platform", "app": "web"},
+			expected:      map[string]string{"team": "platform", "env": "prod"},
+		},
+		{
+			name:     "labels, that are not configured, are removed without defaults",
+			state:    map[string]string{"app": "web"},
+			expected: map[string]string{},
+		},
+		{
+			name:     "labels computed by the resource are kept without defaults",
+			computed: true,
+			state:    map[string]string{"app": "web"},
+		},
+		{
+			name:          "labels computed by the resource are merged with defaults",
+			computed:      true,
+			defaultLabels: defaultLabels,
+			state:         map[string]string{"env": "testing", "app": "web"},
+			expected:      map[string]string{"team": "platform", "env": "testing", "app": "web"},
+		},
+		{
+			name:   "no labels",
+			state:  map[string]string{},
+			config: map[string]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := testDefaultLabelsResource(tc.computed)
+			config, rawConfig := testDefaultLabelsConfig(tc.config)
+
+			diff, err := r.Diff(context.Background(), testDefaultLabelsState(tc.state, rawConfig), config, &Config{DefaultLabels: tc.defaultLabels})
+			require.NoError(t, err)
+
+			if tc.expected == nil {
+				if diff != nil {
+					assert.Empty(t, diff.Attributes)
+				}
+				return
+			}
+			require.NotNil(t, diff)
+			assert.Equal(t, tc.expected, plannedLabels(diff, tc.state))
+		})
+	}
+}
+
+// plannedLabels applies the diff of labels to the labels of the state.
+func plannedLabels(diff *terraform.InstanceDiff, state map[string]string) map[string]string {
+	labels := map[string]string{}
+	for k, v := range state {
+		labels[k] = v
+	}
+	for k, d := range diff.Attributes {
+		key, ok := strings.CutPrefix(k, labelsFieldName+".")
+		if !ok || key == "%" {
+			continue
+		}
+		if d.NewRemoved {
+			delete(labels, key)
+			continue
+		}
+		labels[key] = d.New
+	}
+	return labels
+}
+
+func TestAccProviderDefaultLabels_basic(t *testing.T) {
+	var network vpc.Network
+	networkName := acctest.RandomWithPrefix("tf-network")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"yandex": func() (*schema.Provider, error) {
+				return NewSDKProvider(), nil
+			},
+		},
+		CheckDestroy: testAccCheckVPCNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderDefaultLabels(networkName, "platform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCNetworkExists("yandex_vpc_network.foo", &network),
+					testAccCheckVPCNetworkContainsLabel(&network, "team", "platform"),
+					testAccCheckVPCNetworkContainsLabel(&network, "env", "testing"),
+					testAccCheckVPCNetworkContainsLabel(&network, "tf-label", "tf-label-value"),
+				),
+			},
+			{
+				Config: testAccProviderDefaultLabels(networkName, "devops"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCNetworkExists("yandex_vpc_network.foo", &network),
+					testAccCheckVPCNetworkContainsLabel(&network, "team", "devops"),
+					testAccCheckVPCNetworkContainsLabel(&network, "env", "testing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProviderDefaultLabels(name, team string) string {
+	return fmt.Sprintf(`
+provider "yandex" {
+  default_labels = {
+    team = "%s"
+    env  = "prod"
+  }
+}
+
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+
+  labels = {
+    tf-label = "tf-label-value"
+    env      = "testing"
+  }
+}
+`, team, name)
+}
@@ -2,9 +2,12 @@ package yandex
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
 	"log"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -43,7 +46,17 @@ type iamPolicyModifyFunc func(p *Policy) error
 
 type resourceIDParserFunc func(d *schema.ResourceData, config *Config) error
 
-func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
+// errIamConcurrentModification is returned when access bindings of the roles managed by the resource are
+// changed by someone else since the last refresh or while updating them.
+var errIamConcurrentModification = errors.New("access bindings were modified concurrently")
+
+// iamPolicyReadModifySet reads access bindings of the resource, applies the modification and sends the difference
+// as access binding deltas, so bindings of other roles, which are changed concurrently, are never overwritten.
+// prior holds the bindings of the roles managed by the resource as of the last refresh of its state, it is nil
+// on creation. If members of these roles were changed since then, e.g. by another pipeline between plan and apply,
+// the update is refused with errIamConcurrentModification, so members the plan did not show are never removed.
+// Concurrent changes during the update are reported with errIamConcurrentModification too.
+func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, prior []*access.AccessBinding, modify iamPolicyModifyFunc) error {
 	mutexKey := updater.GetMutexKey()
 	mutexKV.Lock(mutexKey)
 	defer mutexKV.Unlock(mutexKey)
@@ -57,40 +70,56 @@ func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, mod
 
 	log.Printf("[DEBUG]: Retrieved access bindings for %s: %+v\n", updater.DescribeResource(), p)
 
-	err = modify(p)
+	if changed := changedRoles(prior, p.Bindings, bindingRoles(prior)); len(changed) != 0 {
+		return fmt.Errorf("%w: members of roles %s of %s were changed since the last refresh, "+
+			"please refresh the state and review the plan",
+			errIamConcurrentModification, strings.Join(changed, ", "), updater.DescribeResource())
+	}
+
+	modified := &Policy{Bindings: append([]*access.AccessBinding(nil), p.Bindings...)}
+	err = modify(modified)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[DEBUG]: Setting access bindings for %s to %+v\n", updater.DescribeResource(), p)
+	deltas := accessBindingDeltas(p.Bindings, modified.Bindings)
+	if len(deltas) == 0 {
+		log.Printf("[DEBUG]: Access bindings for %s are up to date", updater.DescribeResource())
+		return nil
+	}
+	roles := deltaRoles(deltas)
 
-	err = updater.SetResourceIamPolicy(ctx, p)
+	log.Printf("[DEBUG]: Updating access bindings for %s with %+v\n", updater.DescribeResource(), deltas)
+
+	err = updater.UpdateResourceIamPolicy(ctx, &PolicyDelta{Deltas: deltas})
 	if err != nil {
 		return fmt.Errorf("Error applying access bindings to %s: %w", updater.DescribeResource(), err)
 	}
 
+	updated, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if changed := changedRoles(modified.Bindings, updated.Bindings, roles); len(changed) != 0 {
+		return fmt.Errorf("%w: roles %s of %s were changed while they were updated, please review them",
+			errIamConcurrentModification, strings.Join(changed, ", "), updater.DescribeResource())
+	}
+
 	log.Printf("[DEBUG]: Set policy for %s", updater.DescribeResource())
 
 	return nil
 }
 
+// iamPolicyReadModifyUpdate sends access binding deltas as is. Deltas are applied atomically by the API,
+// so there is no need to read access bindings first.
 func iamPolicyReadModifyUpdate(ctx context.Context, updater ResourceIamUpdater, policyDelta *PolicyDelta) error {
 	mutexKey := updater.GetMutexKey()
 	mutexKV.Lock(mutexKey)
 	defer mutexKV.Unlock(mutexKey)
 
-	log.Printf("[DEBUG]: Retrieving access bindings for %s\n", updater.DescribeResource())
-
-	p, err := updater.GetResourceIamPolicy(ctx)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("[DEBUG]: Retrieved access bindings for %s: %+v\n", updater.DescribeResource(), p)
-
 	log.Printf("[DEBUG]: Updating access bindings of %s with %+v\n", updater.DescribeResource(), policyDelta)
 
-	err = updater.UpdateResourceIamPolicy(ctx, policyDelta)
+	err := updater.UpdateResourceIamPolicy(ctx, policyDelta)
 	if err != nil {
 		return fmt.Errorf("Error updating access bindings of %s: %w", updater.DescribeResource(), err)
 	}
@@ -61,7 +61,7 @@ func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 		}
 
 		p := getResourceIamBindings(d)
-		err = iamPolicyReadModifySet(ctx, updater, func(ep *Policy) error {
+		err = iamPolicyReadModifySet(ctx, updater, nil, func(ep *Policy) error {
 			// Creating a binding does not remove existing members if they are not in the provided members list.
 			// This prevents removing existing permission without the user's knowledge.
 			// Instead, a diff is shown in that case after creation. Subsequent calls to update will remove any
@@ -116,7 +116,10 @@ func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check b
 			if b.RoleId != role {
 				continue
 			}
-			if len(eBindings) != 0 {
+			// Members are limited to the configured ones only right after apply, so the state matches the plan.
+			// Otherwise all members are stored, so members added outside of Terraform are shown in the plan
+			// and are taken into account by the concurrent modification check.
+			if check && len(eBindings) != 0 {
 				for _, e := range eBindings {
 					if canonicalMember(e) != canonicalMember(b) {
 						continue
@@ -156,7 +159,13 @@ func resourceAccessBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 		bindings := getResourceIamBindings(d)
 		role := d.Get("role").(string)
 
-		err = iamPolicyReadModifySet(ctx, updater, func(p *Policy) error {
+		oldMembers, _ := d.GetChange("members")
+		prior := make([]*access.AccessBinding, 0, oldMembers.(*schema.Set).Len())
+		for _, member := range convertStringSet(oldMembers.(*schema.Set)) {
+			prior = append(prior, roleMemberToAccessBinding(role, member))
+		}
+
+		err = iamPolicyReadModifySet(ctx, updater, prior, func(p *Policy) error {
 			p.Bindings = removeRoleFromBindings(role, p.Bindings)
 			p.Bindings = append(p.Bindings, bindings...)
 			return nil
@@ -184,7 +193,7 @@ func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schem
 		}
 		role := d.Get("role").(string)
 
-		err = iamPolicyReadModifySet(ctx, updater, func(p *Policy) error {
+		err = iamPolicyReadModifySet(ctx, updater, binding, func(p *Policy) error {
 			p.Bindings = removeRoleFromBindings(role, p.Bindings)
 			return nil
 		})
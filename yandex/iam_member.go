@@ -13,6 +13,8 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
 )
 
 var IamMemberBaseSchema = map[string]*schema.Schema{
@@ -36,8 +38,7 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 }
 
 func validateIamMember(i interface{}, k string) (s []string, es []error) {
-	chunks := strings.SplitN(i.(string), ":", 2)
-	if len(chunks) == 1 || chunks[0] == "" || chunks[1] == "" {
+	if _, _, err := accessbinding.ParseMember(i.(string)); err != nil {
 		es = append(es, fmt.Errorf("expect 'member' value should be in TYPE:ID format, got '%v'", i.(string)))
 	}
 	return
@@ -57,8 +58,7 @@ func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateContextF
 		id, role, member := s[0], s[1], s[2]
 
 		// |member| part must be in TYPE:ID format.
-		chunks := strings.SplitN(member, ":", 2)
-		if len(chunks) != 2 {
+		if _, _, err := accessbinding.ParseMember(member); err != nil {
 			d.SetId("")
 			return nil, errors.New("Invalid member spec, must be in TYPE:ID format")
 		}
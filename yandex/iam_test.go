@@ -0,0 +1,129 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+// fakeIamUpdater keeps access bindings in memory. beforeGet is called before every read
+// to simulate changes made concurrently by someone else.
+type fakeIamUpdater struct {
+	bindings  []*access.AccessBinding
+	gets      int
+	sets      int
+	updates   int
+	beforeGet func(u *fakeIamUpdater)
+}
+
+func (u *fakeIamUpdater) GetResourceIamPolicy(context.Context) (*Policy, error) {
+	u.gets++
+	if u.beforeGet != nil {
+		u.beforeGet(u)
+	}
+	return &Policy{Bindings: append([]*access.AccessBinding(nil), u.bindings...)}, nil
+}
+
+func (u *fakeIamUpdater) SetResourceIamPolicy(_ context.Context, policy *Policy) error {
+	u.sets++
+	u.bindings = policy.Bindings
+	return nil
+}
+
+func (u *fakeIamUpdater) UpdateResourceIamPolicy(_ context.Context, policy *PolicyDelta) error {
+	u.updates++
+	for _, d := range policy.Deltas {
+		if d.Action == access.AccessBindingAction_ADD {
+			u.bindings = append(u.bindings, d.AccessBinding)
+			continue
+		}
+		var bindings []*access.AccessBinding
+		for _, b := range u.bindings {
+			if b.RoleId != d.AccessBinding.RoleId || canonicalMember(b) != canonicalMember(d.AccessBinding) {
+				bindings = append(bindings, b)
+			}
+		}
+		u.bindings = bindings
+	}
+	return nil
+}
+
+func (u *fakeIamUpdater) GetMutexKey() string      { return "iam-fake-id" }
+func (u *fakeIamUpdater) GetResourceID() string    { return "id" }
+func (u *fakeIamUpdater) DescribeResource() string { return "fake \"id\"" }
+
+func TestAccessBindingDeltas(t *testing.T) {
+	deltas := accessBindingDeltas(
+		[]*access.AccessBinding{
+			roleMemberToAccessBinding("viewer", "userAccount:a"),
+			roleMemberToAccessBinding("editor", "userAccount:b"),
+		},
+		[]*access.AccessBinding{
+			roleMemberToAccessBinding("viewer", "userAccount:a"),
+			roleMemberToAccessBinding("viewer", "serviceAccount:c"),
+		},
+	)
+
+	var got []string
+	for _, d := range deltas {
+		got = append(got, d.Action.String()+" "+d.AccessBinding.RoleId+" "+canonicalMember(d.AccessBinding))
+	}
+	assert.Equal(t, []string{"ADD viewer serviceAccount:c", "REMOVE editor userAccount:b"}, got)
+	assert.Equal(t, []string{"editor", "viewer"}, deltaRoles(deltas))
+}
+
+func TestIamPolicyReadModifySet_preservesOtherRoles(t *testing.T) {
+	u := &fakeIamUpdater{bindings: []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:a")}}
+	u.beforeGet = func(u *fakeIamUpdater) {
+		if u.gets == 1 {
+			u.bindings = append(u.bindings, roleMemberToAccessBinding("admin", "userAccount:x"))
+		}
+	}
+	prior := []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:a")}
+
+	err := iamPolicyReadModifySet(context.Background(), u, prior, func(p *Policy) error {
+		p.Bindings = append(removeRoleFromBindings("viewer", p.Bindings), roleMemberToAccessBinding("viewer", "userAccount:b"))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, u.sets)
+	assert.Equal(t, 2, u.gets)
+	assert.Equal(t, map[string]map[string]bool{
+		"viewer": {"userAccount:b": true},
+		"admin":  {"userAccount:x": true},
+	}, rolesToMembersMap(u.bindings))
+}
+
+func TestIamPolicyReadModifySet_concurrentModification(t *testing.T) {
+	// Another pipeline granted the role between refresh and apply.
+	u := &fakeIamUpdater{bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("viewer", "userAccount:x"),
+	}}
+	prior := []*access.AccessBinding{roleMemberToAccessBinding("viewer", "userAccount:a")}
+
+	err := iamPolicyReadModifySet(context.Background(), u, prior, func(p *Policy) error {
+		p.Bindings = removeRoleFromBindings("viewer", p.Bindings)
+		return nil
+	})
+	require.ErrorIs(t, err, errIamConcurrentModification)
+	assert.Zero(t, u.updates)
+	assert.Equal(t, 1, u.gets)
+	assert.Len(t, u.bindings, 2)
+}
+
+func TestIamPolicyReadModifyUpdate_doesNotRead(t *testing.T) {
+	u := &fakeIamUpdater{}
+
+	err := iamPolicyReadModifyUpdate(context.Background(), u, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{Action: access.AccessBindingAction_ADD, AccessBinding: roleMemberToAccessBinding("viewer", "userAccount:a")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, u.gets)
+	assert.Equal(t, 1, u.updates)
+}
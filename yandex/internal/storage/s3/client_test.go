@@ -0,0 +1,57 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientRequestsIAMTokenForEveryRequest(t *testing.T) {
+	// Custom CA bundle is not supported with the IAM token transport.
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get(iamTokenHeader))
+	}))
+	defer server.Close()
+
+	calls := 0
+	client, err := NewClient(context.Background(), "", "", func(context.Context) (string, error) {
+		calls++
+		return fmt.Sprintf("t1.token-%d", calls), nil
+	}, server.URL)
+	require.NoError(t, err)
+	client.S3().Client.Config.S3ForcePathStyle = aws.Bool(true)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.S3().HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"t1.token-1", "t1.token-2"}, tokens)
+}
+
+func TestNewClientFailsRequestWithoutIAMToken(t *testing.T) {
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent without IAM token")
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), "", "", func(context.Context) (string, error) {
+		return "", fmt.Errorf("token expired")
+	}, server.URL)
+	require.NoError(t, err)
+
+	client.S3().Client.Config.MaxRetries = aws.Int(0)
+	_, err = client.S3().HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	assert.ErrorContains(t, err, "token expired")
+}
@@ -0,0 +1,48 @@
+package s3
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// NewMetadata converts raw user-defined object metadata to a map with
+// lower-cased keys, as Object Storage stores metadata keys case-insensitively.
+func NewMetadata(raw interface{}) map[string]string {
+	metadata := toStringMap(raw)
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	out := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		out[strings.ToLower(k)] = v
+	}
+	return out
+}
+
+func MetadataToS3(metadata map[string]string) map[string]*string {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	out := make(map[string]*string, len(metadata))
+	for k, v := range metadata {
+		out[k] = aws.String(v)
+	}
+	return out
+}
+
+// S3MetadataToRaw converts metadata returned by the API, which has canonical
+// header keys (e.g. "Cache-Key"), back to lower-cased keys.
+func S3MetadataToRaw(metadata map[string]*string) map[string]string {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	out := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		out[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	return out
+}
@@ -0,0 +1,36 @@
+package s3
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestMetadata(t *testing.T) {
+	metadata := NewMetadata(map[string]interface{}{
+		"Build-Id": "42",
+		"owner":    "web",
+	})
+	want := map[string]string{"build-id": "42", "owner": "web"}
+	if !reflect.DeepEqual(metadata, want) {
+		t.Errorf("NewMetadata() = %v, want %v", metadata, want)
+	}
+
+	s3Metadata := MetadataToS3(metadata)
+	if len(s3Metadata) != 2 || aws.StringValue(s3Metadata["build-id"]) != "42" {
+		t.Errorf("MetadataToS3() = %v", s3Metadata)
+	}
+
+	raw := S3MetadataToRaw(map[string]*string{
+		"Build-Id": aws.String("42"),
+		"Owner":    aws.String("web"),
+	})
+	if !reflect.DeepEqual(raw, want) {
+		t.Errorf("S3MetadataToRaw() = %v, want %v", raw, want)
+	}
+
+	if got := NewMetadata(map[string]interface{}{}); got != nil {
+		t.Errorf("NewMetadata() = %v, want nil", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -85,6 +86,13 @@ type CreationData struct {
 	Key                       string
 	ACL                       string
 	ContentType               string
+	CacheControl              string
+	ContentDisposition        string
+	ContentEncoding           string
+	WebsiteRedirect           string
+	ServerSideEncryption      string
+	KMSKeyID                  string
+	Metadata                  map[string]string
 	ObjectLockLegalHoldStatus string
 	ObjectRetention           *ObjectRetention
 	Tags                      []Tag
@@ -108,6 +116,27 @@ func (c *Client) CreateObject(ctx context.Context, data CreationData) (bool, err
 	if data.ContentType != "" {
 		putObjectInput.ContentType = aws.String(data.ContentType)
 	}
+	if data.CacheControl != "" {
+		putObjectInput.CacheControl = aws.String(data.CacheControl)
+	}
+	if data.ContentDisposition != "" {
+		putObjectInput.ContentDisposition = aws.String(data.ContentDisposition)
+	}
+	if data.ContentEncoding != "" {
+		putObjectInput.ContentEncoding = aws.String(data.ContentEncoding)
+	}
+	if data.WebsiteRedirect != "" {
+		putObjectInput.WebsiteRedirectLocation = aws.String(data.WebsiteRedirect)
+	}
+	if data.ServerSideEncryption != "" {
+		putObjectInput.ServerSideEncryption = aws.String(data.ServerSideEncryption)
+	}
+	if data.KMSKeyID != "" {
+		putObjectInput.SSEKMSKeyId = aws.String(data.KMSKeyID)
+	}
+	if len(data.Metadata) > 0 {
+		putObjectInput.Metadata = MetadataToS3(data.Metadata)
+	}
 	if data.ObjectLockLegalHoldStatus != "" {
 		putObjectInput.SetObjectLockLegalHoldStatus(data.ObjectLockLegalHoldStatus)
 	}
@@ -146,6 +175,15 @@ type Object struct {
 	Bucket                    string
 	Key                       string
 	ContentType               *string
+	CacheControl              *string
+	ContentDisposition        *string
+	ContentEncoding           *string
+	WebsiteRedirect           *string
+	ServerSideEncryption      *string
+	KMSKeyID                  *string
+	Metadata                  map[string]string
+	ETag                      string
+	VersionID                 string
 	ObjectLockLegalHoldStatus *string
 	ObjectRetention           *ObjectRetention
 	Tags                      []Tag
@@ -171,6 +209,15 @@ func (c *Client) GetObject(ctx context.Context, bucket, key string) (*Object, er
 		Bucket:                    bucket,
 		Key:                       key,
 		ContentType:               resp.ContentType,
+		CacheControl:              resp.CacheControl,
+		ContentDisposition:        resp.ContentDisposition,
+		ContentEncoding:           resp.ContentEncoding,
+		WebsiteRedirect:           resp.WebsiteRedirectLocation,
+		ServerSideEncryption:      resp.ServerSideEncryption,
+		KMSKeyID:                  resp.SSEKMSKeyId,
+		Metadata:                  S3MetadataToRaw(resp.Metadata),
+		ETag:                      strings.Trim(aws.StringValue(resp.ETag), `"`),
+		VersionID:                 aws.StringValue(resp.VersionId),
 		ObjectLockLegalHoldStatus: resp.ObjectLockLegalHoldStatus,
 	}
 	if resp.ObjectLockMode != nil {
@@ -198,6 +245,71 @@ func (c *Client) GetObject(ctx context.Context, bucket, key string) (*Object, er
 	return object, nil
 }
 
+const (
+	allUsersGroupURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersGroupURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// GetObjectACL returns canned ACL of the object. Empty string is returned, if object grants
+// do not match any canned ACL or there is no permission to read them.
+func (c *Client) GetObjectACL(ctx context.Context, bucket, key string) (string, error) {
+	resp, err := c.s3.GetObjectAclWithContext(ctx, &s3.GetObjectAclInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if IsErr(err, AccessDenied) || IsErr(err, Forbidden) {
+			log.Printf("[WARN] Got an error while trying to read storage object (%s) ACL: %s", key, err)
+			return "", nil
+		}
+		return "", fmt.Errorf("error getting object ACL (%s): %w", key, err)
+	}
+	log.Printf("[DEBUG] Reading storage object ACL: %s", resp)
+
+	return cannedObjectACL(resp.Owner, resp.Grants), nil
+}
+
+func cannedObjectACL(owner *s3.Owner, grants []*s3.Grant) string {
+	var ownerFullControl bool
+	groups := make(map[string]map[string]bool)
+	for _, grant := range grants {
+		if grant.Grantee == nil {
+			return ""
+		}
+		permission := aws.StringValue(grant.Permission)
+		switch {
+		case grant.Grantee.URI != nil:
+			uri := aws.StringValue(grant.Grantee.URI)
+			if groups[uri] == nil {
+				groups[uri] = make(map[string]bool)
+			}
+			groups[uri][permission] = true
+		case owner != nil && aws.StringValue(grant.Grantee.ID) == aws.StringValue(owner.ID) &&
+			permission == s3.PermissionFullControl:
+			ownerFullControl = true
+		default:
+			return ""
+		}
+	}
+	if !ownerFullControl {
+		return ""
+	}
+
+	switch {
+	case len(groups) == 0:
+		return s3.ObjectCannedACLPrivate
+	case len(groups) == 1 && len(groups[allUsersGroupURI]) == 1 && groups[allUsersGroupURI][s3.PermissionRead]:
+		return s3.ObjectCannedACLPublicRead
+	case len(groups) == 1 && len(groups[allUsersGroupURI]) == 2 &&
+		groups[allUsersGroupURI][s3.PermissionRead] && groups[allUsersGroupURI][s3.PermissionWrite]:
+		return s3.ObjectCannedACLPublicReadWrite
+	case len(groups) == 1 && len(groups[authenticatedUsersGroupURI]) == 1 &&
+		groups[authenticatedUsersGroupURI][s3.PermissionRead]:
+		return s3.ObjectCannedACLAuthenticatedRead
+	}
+	return ""
+}
+
 func (c *Client) UpdateObjectACL(ctx context.Context, bucket, key, acl string) error {
 	_, err := c.s3.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
 		Bucket: aws.String(bucket),
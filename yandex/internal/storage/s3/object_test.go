@@ -0,0 +1,73 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestCannedObjectACL(t *testing.T) {
+	owner := &s3.Owner{ID: aws.String("owner")}
+	ownerGrant := &s3.Grant{
+		Grantee:    &s3.Grantee{ID: aws.String("owner"), Type: aws.String(s3.TypeCanonicalUser)},
+		Permission: aws.String(s3.PermissionFullControl),
+	}
+	groupGrant := func(uri, permission string) *s3.Grant {
+		return &s3.Grant{
+			Grantee:    &s3.Grantee{URI: aws.String(uri), Type: aws.String(s3.TypeGroup)},
+			Permission: aws.String(permission),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		grants []*s3.Grant
+		want   string
+	}{
+		{
+			name:   "private",
+			grants: []*s3.Grant{ownerGrant},
+			want:   s3.ObjectCannedACLPrivate,
+		},
+		{
+			name:   "public read",
+			grants: []*s3.Grant{ownerGrant, groupGrant(allUsersGroupURI, s3.PermissionRead)},
+			want:   s3.ObjectCannedACLPublicRead,
+		},
+		{
+			name: "public read write",
+			grants: []*s3.Grant{
+				ownerGrant,
+				groupGrant(allUsersGroupURI, s3.PermissionRead),
+				groupGrant(allUsersGroupURI, s3.PermissionWrite),
+			},
+			want: s3.ObjectCannedACLPublicReadWrite,
+		},
+		{
+			name:   "authenticated read",
+			grants: []*s3.Grant{ownerGrant, groupGrant(authenticatedUsersGroupURI, s3.PermissionRead)},
+			want:   s3.ObjectCannedACLAuthenticatedRead,
+		},
+		{
+			name: "custom grant",
+			grants: []*s3.Grant{ownerGrant, {
+				Grantee:    &s3.Grantee{ID: aws.String("other"), Type: aws.String(s3.TypeCanonicalUser)},
+				Permission: aws.String(s3.PermissionRead),
+			}},
+			want: "",
+		},
+		{
+			name:   "no owner grant",
+			grants: []*s3.Grant{groupGrant(allUsersGroupURI, s3.PermissionRead)},
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cannedObjectACL(owner, tt.grants); got != tt.want {
+				t.Errorf("cannedObjectACL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
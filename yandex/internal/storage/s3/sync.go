@@ -0,0 +1,106 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"golang.org/x/sync/errgroup"
+)
+
+// deleteObjectsBatchSize is the maximum number of keys accepted by a single
+// DeleteObjects request.
+const deleteObjectsBatchSize = 1000
+
+// ListObjectKeys returns the keys of all objects in the bucket starting with the prefix.
+func (c *Client) ListObjectKeys(ctx context.Context, bucket, prefix string) (map[string]struct{}, error) {
+	keys := make(map[string]struct{})
+	err := c.s3.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			keys[aws.StringValue(object.Key)] = struct{}{}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing objects with prefix %q in bucket %q: %w", prefix, bucket, err)
+	}
+	return keys, nil
+}
+
+// UploadObjects uploads objects running up to concurrency uploads at once. The
+// callback is called for every successfully uploaded object, so that callers
+// can track progress in case of a partial failure.
+func (c *Client) UploadObjects(
+	ctx context.Context,
+	objects []CreationData,
+	concurrency int,
+	uploaded func(key string),
+) error {
+	var mu sync.Mutex
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
+	for _, object := range objects {
+		object := object
+		group.Go(func() error {
+			log.Printf("[DEBUG] Uploading storage object %q to bucket %q", object.Key, object.Bucket)
+			if _, err := c.CreateObject(ctx, object); err != nil {
+				return fmt.Errorf("error uploading object %q: %w", object.Key, err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			uploaded(object.Key)
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
+// DeleteObjects deletes the objects with the given keys in batches. The callback
+// is called for every successfully deleted object.
+func (c *Client) DeleteObjects(ctx context.Context, bucket string, keys []string, deleted func(key string)) error {
+	for start := 0; start < len(keys); start += deleteObjectsBatchSize {
+		end := start + deleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		identifiers := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			identifiers = append(identifiers, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		log.Printf("[DEBUG] Deleting %d storage objects from bucket %q", len(identifiers), bucket)
+		resp, err := c.s3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: identifiers,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting objects in bucket %q: %w", bucket, err)
+		}
+
+		failed := make(map[string]struct{}, len(resp.Errors))
+		for _, e := range resp.Errors {
+			failed[aws.StringValue(e.Key)] = struct{}{}
+		}
+		for _, key := range keys[start:end] {
+			if _, ok := failed[key]; !ok {
+				deleted(key)
+			}
+		}
+		if len(resp.Errors) > 0 {
+			e := resp.Errors[0]
+			return fmt.Errorf("error deleting %d objects in bucket %q, first error on %q: %s: %s",
+				len(resp.Errors), bucket, aws.StringValue(e.Key), aws.StringValue(e.Code), aws.StringValue(e.Message))
+		}
+	}
+	return nil
+}
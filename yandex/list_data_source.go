@@ -0,0 +1,126 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
+)
+
+// listDataSourceSchema returns the schema of a data source that lists objects of a folder.
+// Objects are filtered by the server-side `filter` expression first and then by `labels`.
+// IDs of found objects are exported as `ids`, their key attributes as a list under listPropName.
+func listDataSourceSchema(listPropName string, elem *schema.Resource) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"folder_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"filter": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		listPropName: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     elem,
+		},
+	}
+}
+
+type listDataSourceFilter struct {
+	folderID string
+	filter   string
+	labels   map[string]string
+	// args holds values of the filter arguments specific to the data source.
+	args map[string]string
+}
+
+// expandListDataSourceFilter reads the common filter arguments and the given string arguments
+// specific to the data source, so all of them are taken into account by the data source ID.
+func expandListDataSourceFilter(d *schema.ResourceData, config *Config, args ...string) (*listDataSourceFilter, error) {
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting folder ID while listing objects: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return nil, err
+	}
+
+	argValues := make(map[string]string, len(args))
+	for _, arg := range args {
+		argValues[arg] = d.Get(arg).(string)
+	}
+
+	return &listDataSourceFilter{
+		folderID: folderID,
+		filter:   d.Get("filter").(string),
+		labels:   labels,
+		args:     argValues,
+	}, nil
+}
+
+// matchLabels reports whether labels contain all of the filter labels.
+func (f *listDataSourceFilter) matchLabels(labels map[string]string) bool {
+	for k, v := range f.labels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// id returns a stable data source ID derived from all filter arguments.
+func (f *listDataSourceFilter) id() string {
+	var buf strings.Builder
+	buf.WriteString(f.folderID + "\n" + f.filter + "\n")
+	writeSortedMap(&buf, f.labels)
+	if len(f.args) != 0 {
+		buf.WriteString("\n")
+		writeSortedMap(&buf, f.args)
+	}
+	return fmt.Sprintf("%d", hashcode.String(buf.String()))
+}
+
+func writeSortedMap(buf *strings.Builder, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		buf.WriteString(k + "=" + m[k] + "\n")
+	}
+}
+
+func setListDataSourceResult(d *schema.ResourceData, f *listDataSourceFilter, listPropName string, ids []string, items []map[string]interface{}) error {
+	if err := d.Set("folder_id", f.folderID); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set(listPropName, items); err != nil {
+		return err
+	}
+
+	d.SetId(f.id())
+	return nil
+}
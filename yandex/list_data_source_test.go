@@ -0,0 +1,64 @@
+package yandex
+
+import (
+	"testing"
+)
+
+func TestListDataSourceFilterMatchLabels(t *testing.T) {
+	f := &listDataSourceFilter{labels: map[string]string{"backup": "true", "env": "dev"}}
+
+	cases := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{"all match", map[string]string{"backup": "true", "env": "dev", "team": "a"}, true},
+		{"value differs", map[string]string{"backup": "false", "env": "dev"}, false},
+		{"key missing", map[string]string{"backup": "true"}, false},
+		{"no labels", nil, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := f.matchLabels(c.labels); got != c.want {
+				t.Errorf("matchLabels(%v) = %v, want %v", c.labels, got, c.want)
+			}
+		})
+	}
+
+	empty := &listDataSourceFilter{}
+	if !empty.matchLabels(nil) {
+		t.Errorf("filter without labels must match any object")
+	}
+}
+
+func TestListDataSourceFilterID(t *testing.T) {
+	a := &listDataSourceFilter{folderID: "folder", filter: `name = "x"`, labels: map[string]string{"a": "1", "b": "2"}}
+	b := &listDataSourceFilter{folderID: "folder", filter: `name = "x"`, labels: map[string]string{"b": "2", "a": "1"}}
+	if a.id() != b.id() {
+		t.Errorf("id must not depend on labels order: %s != %s", a.id(), b.id())
+	}
+
+	c := &listDataSourceFilter{folderID: "folder", filter: `name = "y"`, labels: a.labels}
+	if a.id() == c.id() {
+		t.Errorf("id must depend on filter")
+	}
+
+	other := &listDataSourceFilter{folderID: "other", filter: a.filter, labels: a.labels}
+	if a.id() == other.id() {
+		t.Errorf("id must depend on folder")
+	}
+
+	network := &listDataSourceFilter{folderID: "folder", filter: a.filter, labels: a.labels,
+		args: map[string]string{"network_id": "net1", "zone": ""}}
+	otherNetwork := &listDataSourceFilter{folderID: "folder", filter: a.filter, labels: a.labels,
+		args: map[string]string{"network_id": "net2", "zone": ""}}
+	if network.id() == otherNetwork.id() || network.id() == a.id() {
+		t.Errorf("id must depend on data source specific arguments")
+	}
+
+	zone := &listDataSourceFilter{folderID: "folder", filter: a.filter, labels: a.labels,
+		args: map[string]string{"network_id": "net1", "zone": "ru-central1-a"}}
+	if network.id() == zone.id() {
+		t.Errorf("id must depend on zone")
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"os/exec"
 	"regexp"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxpayload"
 )
 
 type lockboxEntryCheck struct {
@@ -53,21 +56,33 @@ func expandLockboxSecretVersionEntriesSlice(ctx context.Context, d *schema.Resou
 	return slice, nil
 }
 
-func expandLockboxSecretVersionEntries(ctx context.Context, d *schema.ResourceData, indexes ...interface{}) (*lockbox.PayloadEntryChange, error) {
+func expandLockboxSecretVersionEntries(ctx context.Context, d *schema.ResourceData, index int) (*lockbox.PayloadEntryChange, error) {
 	val := new(lockbox.PayloadEntryChange)
 
-	if v, ok := d.GetOk(fmt.Sprintf("entries.%d.key", indexes...)); ok {
+	if v, ok := d.GetOk(fmt.Sprintf("entries.%d.key", index)); ok {
 		val.SetKey(v.(string))
 	}
 
-	if v, ok := d.GetOk(fmt.Sprintf("entries.%d.text_value", indexes...)); ok {
+	if v, ok := d.GetOk(fmt.Sprintf("entries.%d.text_value", index)); ok {
 		val.SetTextValue(v.(string))
 	}
 
-	if execRaw, ok := d.GetOk(fmt.Sprintf("entries.%d.command.0", indexes...)); ok {
+	// Write-only values are never stored in the state, so they can be read from the raw config only.
+	woValue, err := getWriteOnlyString(d, cty.GetAttrPath("entries").IndexInt(index).GetAttr("text_value_wo"))
+	if err != nil {
+		return nil, err
+	}
+	if woValue != "" {
+		if val.GetTextValue() != "" {
+			return nil, fmt.Errorf("key %v has both text_value and text_value_wo, but only one of those must be set", val.GetKey())
+		}
+		val.SetTextValue(woValue)
+	}
+
+	if execRaw, ok := d.GetOk(fmt.Sprintf("entries.%d.command.0", index)); ok {
 		if val.GetTextValue() != "" {
 			// We must validate manually - https://github.com/hashicorp/terraform-plugin-sdk/issues/470
-			return nil, fmt.Errorf("key %v has both text_value and command, but only one of those must be set", val.GetKey())
+			return nil, fmt.Errorf("key %v has both text_value (or text_value_wo) and command, but only one of those must be set", val.GetKey())
 		}
 		execMap := execRaw.(map[string]interface{})
 		result, err := resolveCommand(ctx, execMap)
@@ -84,6 +99,18 @@ func expandLockboxSecretVersionEntries(ctx context.Context, d *schema.ResourceDa
 	return val, nil
 }
 
+// getWriteOnlyString returns the value of a write-only string attribute, or an empty string if it's not set.
+func getWriteOnlyString(d *schema.ResourceData, path cty.Path) (string, error) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", fmt.Errorf("could not read write-only attribute %v: %v", path, diags)
+	}
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
+
 func resolveCommand(ctx context.Context, command map[string]interface{}) (string, error) {
 	path := command["path"].(string)
 	envMap := expandStringStringMap(command["env"].(map[string]interface{}))
@@ -113,20 +140,17 @@ func runCommand(ctx context.Context, path string, envMap map[string]string, args
 }
 
 func flattenLockboxSecretVersionEntriesSlice(vs []*lockbox.Payload_Entry) ([]interface{}, error) {
-	s := make([]interface{}, 0, len(vs))
-	for _, v := range vs {
-		s = append(s, flattenLockboxSecretVersionEntry(v))
+	entries := lockboxpayload.FlattenEntries(vs)
+	s := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		s = append(s, map[string]interface{}{
+			"key":        e.Key.ValueString(),
+			"text_value": e.TextValue.ValueString(),
+		})
 	}
 	return s, nil
 }
 
-func flattenLockboxSecretVersionEntry(v *lockbox.Payload_Entry) map[string]interface{} {
-	return map[string]interface{}{
-		"key":        v.Key,
-		"text_value": v.GetTextValue(),
-	}
-}
-
 func flattenPasswordPayloadSpecification(passwordPayloadSpecification *lockbox.PasswordPayloadSpecification) []map[string]interface{} {
 	if passwordPayloadSpecification == nil {
 		return nil
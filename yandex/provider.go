@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/credentials"
 	"github.com/yandex-cloud/terraform-provider-yandex/version"
 )
 
@@ -75,6 +76,46 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 				ConflictsWith: []string{"token"},
 				ValidateFunc:  validateSAKey,
 			},
+			"token_command": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   common.Descriptions["token_command"],
+				ConflictsWith: []string{"token", "service_account_key_file", "token_file", "oidc_token", "oidc_token_file"},
+			},
+			"token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   common.Descriptions["token_file"],
+				ConflictsWith: []string{"token", "service_account_key_file", "oidc_token", "oidc_token_file"},
+			},
+			"oidc_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   common.Descriptions["oidc_token"],
+				ConflictsWith: []string{"token", "service_account_key_file", "oidc_token_file"},
+			},
+			"oidc_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   common.Descriptions["oidc_token_file"],
+				ConflictsWith: []string{"token", "service_account_key_file"},
+			},
+			"oidc_service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: common.Descriptions["oidc_service_account_id"],
+			},
+			"oidc_token_exchange_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: common.Descriptions["oidc_token_exchange_endpoint"],
+			},
+			"impersonate_service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: common.Descriptions["impersonate_service_account_id"],
+			},
 			"storage_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -132,6 +173,12 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"default_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: common.Descriptions["default_labels"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -153,14 +200,18 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_container_repository":                             dataSourceYandexContainerRepository(),
 			"yandex_container_repository_lifecycle_policy":            dataSourceYandexContainerRepositoryLifecyclePolicy(),
 			"yandex_compute_disk":                                     dataSourceYandexComputeDisk(),
+			"yandex_compute_disks":                                    dataSourceYandexComputeDisks(),
 			"yandex_compute_disk_placement_group":                     dataSourceYandexComputeDiskPlacementGroup(),
 			"yandex_compute_filesystem":                               dataSourceYandexComputeFilesystem(),
 			"yandex_compute_gpu_cluster":                              dataSourceYandexComputeGpuCluster(),
 			"yandex_compute_image":                                    dataSourceYandexComputeImage(),
+			"yandex_compute_images":                                   dataSourceYandexComputeImages(),
 			"yandex_compute_instance":                                 dataSourceYandexComputeInstance(),
+			"yandex_compute_instances":                                dataSourceYandexComputeInstances(),
 			"yandex_compute_instance_group":                           dataSourceYandexComputeInstanceGroup(),
 			"yandex_compute_placement_group":                          dataSourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 dataSourceYandexComputeSnapshot(),
+			"yandex_compute_snapshots":                                dataSourceYandexComputeSnapshots(),
 			"yandex_compute_snapshot_schedule":                        dataSourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
@@ -218,11 +269,15 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_resourcemanager_folder":                           dataSourceYandexResourceManagerFolder(),
 			"yandex_serverless_container":                             dataSourceYandexServerlessContainer(),
 			"yandex_vpc_address":                                      dataSourceYandexVPCAddress(),
+			"yandex_vpc_addresses":                                    dataSourceYandexVPCAddresses(),
 			"yandex_vpc_gateway":                                      dataSourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      dataSourceYandexVPCNetwork(),
+			"yandex_vpc_networks":                                     dataSourceYandexVPCNetworks(),
 			"yandex_vpc_route_table":                                  dataSourceYandexVPCRouteTable(),
 			"yandex_vpc_security_group":                               dataSourceYandexVPCSecurityGroup(),
+			"yandex_vpc_security_groups":                              dataSourceYandexVPCSecurityGroups(),
 			"yandex_vpc_subnet":                                       dataSourceYandexVPCSubnet(),
+			"yandex_vpc_subnets":                                      dataSourceYandexVPCSubnets(),
 			"yandex_vpc_private_endpoint":                             dataSourceYandexVPCPrivateEndpoint(),
 			"yandex_ydb_database_dedicated":                           dataSourceYandexYDBDatabaseDedicated(),
 			"yandex_ydb_database_serverless":                          dataSourceYandexYDBDatabaseServerless(),
@@ -255,6 +310,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_cm_certificate_iam_binding":                       resourceYandexCMCertificateIAMBinding(),
 			"yandex_cm_certificate_iam_member":                        resourceYandexCMCertificateIAMMember(),
 			"yandex_compute_disk":                                     resourceYandexComputeDisk(),
+			"yandex_compute_disk_attachment":                          resourceYandexComputeDiskAttachment(),
 			"yandex_compute_disk_placement_group":                     resourceYandexComputeDiskPlacementGroup(),
 			"yandex_compute_filesystem":                               resourceYandexComputeFilesystem(),
 			"yandex_compute_gpu_cluster":                              resourceYandexComputeGpuCluster(),
@@ -350,6 +406,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_serverless_container_iam_binding":                 resourceYandexServerlessContainerIAMBinding(),
 			"yandex_storage_bucket":                                   resourceYandexStorageBucket(),
 			"yandex_storage_object":                                   resourceYandexStorageObject(),
+			"yandex_storage_objects_sync":                             resourceYandexStorageObjectsSync(),
 			"yandex_vpc_address":                                      resourceYandexVPCAddress(),
 			"yandex_vpc_default_security_group":                       resourceYandexVPCDefaultSecurityGroup(),
 			"yandex_vpc_gateway":                                      resourceYandexVPCGateway(),
@@ -376,6 +433,8 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 		return providerConfigure(ctx, d, provider, emptyFolder, false)
 	}
 
+	withDefaultLabels(provider.ResourcesMap)
+
 	return provider
 }
 
@@ -422,21 +481,20 @@ func setToDefaultIfNeeded(field string, osEnvName string, defaultVal string) str
 	return field
 }
 
-func setToDefaultBoolIfNeeded(osEnvName string, defaultVal bool) bool {
-	field := os.Getenv(osEnvName)
-	v, err := strconv.ParseBool(field)
-	if err != nil {
-		return v
-	} else {
-		return defaultVal
+// setToDefaultBoolIfNeeded returns the configured value, if it is enabled, or the value of the environment variable.
+func setToDefaultBoolIfNeeded(osEnvName string, val bool) bool {
+	if val {
+		return val
 	}
+	v, err := strconv.ParseBool(os.Getenv(osEnvName))
+	return err == nil && v
 }
 
 // testConfig is used to avoid using StopContext duo to tests are run in parallel and context is cancelled randomly in tests
 // there is same following issue https://github.com/hashicorp/terraform-plugin-sdk/issues/966
 func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Provider, emptyFolder bool, testConfig bool) (interface{}, diag.Diagnostics) {
 	config := Config{
-		Endpoint:                       setToDefaultIfNeeded(d.Get("endpoint").(string), "YC_ENDPOINT", common.DefaultEndpoint),
+		Endpoint:                       setToDefaultIfNeeded(d.Get("endpoint").(string), "YC_ENDPOINT", ""),
 		FolderID:                       setToDefaultIfNeeded(d.Get("folder_id").(string), "YC_FOLDER_ID", ""),
 		CloudID:                        setToDefaultIfNeeded(d.Get("cloud_id").(string), "YC_CLOUD_ID", ""),
 		OrganizationID:                 setToDefaultIfNeeded(d.Get("organization_id").(string), "YC_ORGANIZATION_ID", ""),
@@ -444,6 +502,13 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		Zone:                           setToDefaultIfNeeded(d.Get("zone").(string), "YC_ZONE", ""),
 		Token:                          setToDefaultIfNeeded(d.Get("token").(string), "YC_TOKEN", ""),
 		ServiceAccountKeyFileOrContent: setToDefaultIfNeeded(d.Get("service_account_key_file").(string), "YC_SERVICE_ACCOUNT_KEY_FILE", ""),
+		TokenCommand:                   setToDefaultIfNeeded(d.Get("token_command").(string), "YC_TOKEN_COMMAND", ""),
+		TokenFile:                      setToDefaultIfNeeded(d.Get("token_file").(string), "YC_TOKEN_FILE", ""),
+		OIDCToken:                      setToDefaultIfNeeded(d.Get("oidc_token").(string), "YC_OIDC_TOKEN", ""),
+		OIDCTokenFile:                  setToDefaultIfNeeded(d.Get("oidc_token_file").(string), "YC_OIDC_TOKEN_FILE", ""),
+		OIDCServiceAccountID:           setToDefaultIfNeeded(d.Get("oidc_service_account_id").(string), "YC_OIDC_SERVICE_ACCOUNT_ID", ""),
+		OIDCTokenExchangeEndpoint:      setToDefaultIfNeeded(d.Get("oidc_token_exchange_endpoint").(string), "YC_OIDC_TOKEN_EXCHANGE_ENDPOINT", common.DefaultOIDCTokenExchangeEndpoint),
+		ImpersonateServiceAccountID:    setToDefaultIfNeeded(d.Get("impersonate_service_account_id").(string), "YC_IMPERSONATE_SERVICE_ACCOUNT_ID", ""),
 		StorageEndpoint:                setToDefaultIfNeeded(d.Get("storage_endpoint").(string), "YC_STORAGE_ENDPOINT_URL", common.DefaultStorageEndpoint),
 		StorageAccessKey:               setToDefaultIfNeeded(d.Get("storage_access_key").(string), "YC_STORAGE_ACCESS_KEY", ""),
 		StorageSecretKey:               setToDefaultIfNeeded(d.Get("storage_secret_key").(string), "YC_STORAGE_SECRET_KEY", ""),
@@ -456,9 +521,15 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		MaxRetries:            d.Get("max_retries").(int),
 		SharedCredentialsFile: d.Get("shared_credentials_file").(string),
 		Profile:               d.Get("profile").(string),
+		DefaultLabels:         expandStringStringMap(d.Get("default_labels").(map[string]interface{})),
 		userAgent:             p.UserAgent("terraform-provider-yandex", version.ProviderVersion),
 	}
 
+	// Explicitly specified profile refers to yc CLI configuration, unless a shared credentials file is given.
+	if len(config.SharedCredentialsFile) == 0 && len(config.Profile) != 0 {
+		config.SharedCredentialsFile = credentials.ExistingYCConfigFile()
+	}
+
 	if len(config.Profile) == 0 {
 		config.Profile = "default"
 	}
@@ -169,6 +169,28 @@ func TestProviderDefaultValues(t *testing.T) {
 	}
 }
 
+func TestSetToDefaultBoolIfNeeded(t *testing.T) {
+	cases := []struct {
+		name     string
+		env      string
+		val      bool
+		expected bool
+	}{
+		{"configured value", "", true, true},
+		{"configured value takes precedence over env", "false", true, true},
+		{"env enables", "true", false, true},
+		{"env disables", "false", false, false},
+		{"invalid env", "yes please", false, false},
+		{"neither", "", false, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("YC_PLAINTEXT", tc.env)
+			assert.Equal(t, tc.expected, setToDefaultBoolIfNeeded("YC_PLAINTEXT", tc.val))
+		})
+	}
+}
+
 func TestProviderOrganizationId(t *testing.T) {
 	// save OS env vars
 	envVars := []string{"YC_ORGANIZATION_ID"}
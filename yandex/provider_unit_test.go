@@ -0,0 +1,247 @@
+package yandex
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+// Unit tests run full CRUD against the in-process fake of Yandex Cloud API and do not require cloud credentials.
+
+func newFakeCloudTestCase(t *testing.T) (resource.TestCase, *fakecloud.Server) {
+	fakecloud.SkipWithoutTerraform(t)
+	fakecloud.IsolateEnv(t)
+
+	server := fakecloud.New(t)
+	return resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"yandex": func() (*schema.Provider, error) {
+				return NewSDKProvider(), nil
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			for name, rs := range s.RootModule().Resources {
+				if !strings.HasPrefix(name, "data.") && server.Exists(rs.Primary.ID) {
+					return fmt.Errorf("%s %s still exists", name, rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+	}, server
+}
+
+func TestUnitVPCNetworkAndSubnet_fakeCloud(t *testing.T) {
+	config := func(name, cidr string) string {
+		return fmt.Sprintf(`
+resource "yandex_vpc_network" "test" {
+  name   = "%[1]s"
+  labels = {
+    env = "test"
+  }
+}
+
+resource "yandex_vpc_subnet" "test" {
+  name           = "%[1]s-subnet"
+  network_id     = yandex_vpc_network.test.id
+  v4_cidr_blocks = ["%[2]s"]
+}
+`, name, cidr)
+	}
+
+	testCase, server := newFakeCloudTestCase(t)
+	testCase.Steps = []resource.TestStep{
+		{
+			Config: server.ProviderConfig() + config("network", "10.0.0.0/24"),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("yandex_vpc_network.test", "folder_id", fakecloud.FolderID),
+				resource.TestCheckResourceAttr("yandex_vpc_network.test", "labels.env", "test"),
+				resource.TestCheckResourceAttr("yandex_vpc_network.test", "subnet_ids.#", "1"),
+				resource.TestCheckResourceAttr("yandex_vpc_subnet.test", "zone", fakecloud.Zone),
+				resource.TestCheckResourceAttrPair("yandex_vpc_subnet.test", "network_id", "yandex_vpc_network.test", "id"),
+			),
+		},
+		{
+			Config: server.ProviderConfig() + config("network-updated", "10.0.0.0/24"),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("yandex_vpc_network.test", "name", "network-updated"),
+				resource.TestCheckResourceAttr("yandex_vpc_subnet.test", "name", "network-updated-subnet"),
+			),
+		},
+		{
+			ResourceName:      "yandex_vpc_network.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		{
+			ResourceName:      "yandex_vpc_subnet.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	resource.UnitTest(t, testCase)
+}
+
+func TestUnitIAMServiceAccount_fakeCloud(t *testing.T) {
+	config := func(description string) string {
+		return fmt.Sprintf(`
+resource "yandex_iam_service_account" "test" {
+  name        = "sa"
+  description = "%s"
+}
+`, description)
+	}
+
+	testCase, server := newFakeCloudTestCase(t)
+	testCase.Steps = []resource.TestStep{
+		{
+			Config: server.ProviderConfig() + config("created"),
+			Check:  resource.TestCheckResourceAttr("yandex_iam_service_account.test", "description", "created"),
+		},
+		{
+			Config: server.ProviderConfig() + config("updated"),
+			Check:  resource.TestCheckResourceAttr("yandex_iam_service_account.test", "description", "updated"),
+		},
+		{
+			ResourceName:      "yandex_iam_service_account.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	resource.UnitTest(t, testCase)
+}
+
+func TestUnitComputeDisk_fakeCloud(t *testing.T) {
+	config := func(size int) string {
+		return fmt.Sprintf(`
+data "yandex_resourcemanager_folder" "default" {
+  folder_id = "%s"
+}
+
+resource "yandex_compute_disk" "test" {
+  name      = "disk"
+  folder_id = data.yandex_resourcemanager_folder.default.id
+  size      = %d
+}
+`, fakecloud.FolderID, size)
+	}
+
+	testCase, server := newFakeCloudTestCase(t)
+	testCase.Steps = []resource.TestStep{
+		{
+			Config: server.ProviderConfig() + config(10),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.yandex_resourcemanager_folder.default", "cloud_id", fakecloud.CloudID),
+				resource.TestCheckResourceAttr("yandex_compute_disk.test", "size", "10"),
+				resource.TestCheckResourceAttr("yandex_compute_disk.test", "status", "ready"),
+			),
+		},
+		{
+			Config: server.ProviderConfig() + config(20),
+			Check:  resource.TestCheckResourceAttr("yandex_compute_disk.test", "size", "20"),
+		},
+	}
+	resource.UnitTest(t, testCase)
+}
+
+func TestUnitComputeDisks_fakeCloud(t *testing.T) {
+	config := fmt.Sprintf(`
+resource "yandex_compute_disk" "backup" {
+  count     = 2
+  name      = "backup-${count.index}"
+  folder_id = "%[1]s"
+  size      = 10
+
+  labels = {
+    backup = "true"
+  }
+}
+
+resource "yandex_compute_disk" "scratch" {
+  name      = "scratch"
+  folder_id = "%[1]s"
+  size      = 10
+}
+
+data "yandex_compute_disks" "backup" {
+  folder_id = "%[1]s"
+
+  labels = {
+    backup = "true"
+  }
+
+  depends_on = [yandex_compute_disk.backup, yandex_compute_disk.scratch]
+}
+
+data "yandex_compute_disks" "scratch" {
+  folder_id = "%[1]s"
+  filter    = "name = \"scratch\""
+
+  depends_on = [yandex_compute_disk.backup, yandex_compute_disk.scratch]
+}
+`, fakecloud.FolderID)
+
+	testCase, server := newFakeCloudTestCase(t)
+	testCase.Steps = []resource.TestStep{
+		{
+			Config: server.ProviderConfig() + config,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.yandex_compute_disks.backup", "ids.#", "2"),
+				resource.TestCheckResourceAttr("data.yandex_compute_disks.backup", "disks.0.labels.backup", "true"),
+				resource.TestCheckResourceAttr("data.yandex_compute_disks.scratch", "ids.#", "1"),
+				resource.TestCheckResourceAttrPair("data.yandex_compute_disks.scratch", "ids.0", "yandex_compute_disk.scratch", "id"),
+				resource.TestCheckResourceAttr("data.yandex_compute_disks.scratch", "disks.0.size", "10"),
+			),
+		},
+	}
+	resource.UnitTest(t, testCase)
+}
+
+func TestUnitVPCSubnets_fakeCloud(t *testing.T) {
+	config := fmt.Sprintf(`
+resource "yandex_vpc_network" "shared" {
+  name      = "shared"
+  folder_id = "%[1]s"
+}
+
+resource "yandex_vpc_subnet" "subnet" {
+  for_each = {
+    "ru-central1-a" = "10.1.0.0/24"
+    "ru-central1-b" = "10.2.0.0/24"
+    "ru-central1-d" = "10.3.0.0/24"
+  }
+
+  folder_id      = "%[1]s"
+  network_id     = yandex_vpc_network.shared.id
+  zone           = each.key
+  v4_cidr_blocks = [each.value]
+}
+
+data "yandex_vpc_subnets" "zone_b" {
+  folder_id  = "%[1]s"
+  network_id = yandex_vpc_network.shared.id
+  zone       = "ru-central1-b"
+
+  depends_on = [yandex_vpc_subnet.subnet]
+}
+`, fakecloud.FolderID)
+
+	testCase, server := newFakeCloudTestCase(t)
+	testCase.Steps = []resource.TestStep{
+		{
+			Config: server.ProviderConfig() + config,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.yandex_vpc_subnets.zone_b", "ids.#", "1"),
+				resource.TestCheckResourceAttrPair("data.yandex_vpc_subnets.zone_b", "ids.0",
+					`yandex_vpc_subnet.subnet["ru-central1-b"]`, "id"),
+				resource.TestCheckResourceAttr("data.yandex_vpc_subnets.zone_b", "subnets.0.v4_cidr_blocks.0", "10.2.0.0/24"),
+			),
+		},
+	}
+	resource.UnitTest(t, testCase)
+}
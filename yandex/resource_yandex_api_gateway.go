@@ -24,6 +24,10 @@ func resourceYandexApiGateway() *schema.Resource {
 		Update: resourceYandexApiGatewayUpdate,
 		Delete: resourceYandexApiGatewayDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexApiGatewayDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexApiGatewayDefaultTimeout),
@@ -432,6 +436,19 @@ func resourceYandexApiGatewayRead(d *schema.ResourceData, meta interface{}) erro
 		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud API Gateway %q", d.Id()))
 	}
 
+	// Specification is read only when it is absent in the state, e.g. after import,
+	// since the API returns it reformatted.
+	if _, ok := d.GetOk("spec"); !ok {
+		specResp, err := config.sdk.Serverless().APIGateway().ApiGateway().GetOpenapiSpec(ctx, &apigateway.GetOpenapiSpecRequest{
+			ApiGatewayId: d.Id(),
+			Format:       apigateway.GetOpenapiSpecRequest_YAML,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get specification of Yandex Cloud API Gateway %q: %s", d.Id(), err)
+		}
+		d.Set("spec", specResp.OpenapiSpec)
+	}
+
 	return flattenYandexApiGateway(d, apiGateway, false)
 }
 
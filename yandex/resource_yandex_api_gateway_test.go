@@ -113,6 +113,7 @@ func TestAccYandexAPIGateway_update(t *testing.T) {
 		Steps: []resource.TestStep{
 			basicYandexAPIGatewayTestStep(apiGatewayName, apiGatewayDesc, labelKey, labelValue, spec, &apiGateway),
 			basicYandexAPIGatewayTestStep(apiGatewayNameUpdated, apiGatewayDescUpdated, labelKeyUpdated, labelValueUpdated, specUpdated, &apiGateway),
+			apiGatewayImportTestStep(),
 		},
 	})
 }
@@ -859,3 +860,12 @@ func testWriteResourceYandexAPIGateway(
 	}
 	fprintfLn(sb, "}")
 }
+
+func apiGatewayImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            apiGatewayResource,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"spec"},
+	}
+}
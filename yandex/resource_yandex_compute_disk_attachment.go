@@ -0,0 +1,182 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func resourceYandexComputeDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexComputeDiskAttachmentCreate,
+		Read:   resourceYandexComputeDiskAttachmentRead,
+		Delete: resourceYandexComputeDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexComputeDiskAttachmentImportState,
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"device_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "READ_WRITE",
+				ValidateFunc: validation.StringInSlice([]string{"READ_WRITE", "READ_ONLY"}, false),
+			},
+
+			"auto_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceYandexComputeDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Get("instance_id").(string)
+
+	diskSpec, err := expandSecondaryDiskSpec(map[string]interface{}{
+		"disk_id":     d.Get("disk_id"),
+		"device_name": d.Get("device_name"),
+		"mode":        d.Get("mode"),
+		"auto_delete": d.Get("auto_delete"),
+	})
+	if err != nil {
+		return err
+	}
+
+	mutexKV.Lock(instanceID)
+	defer mutexKV.Unlock(instanceID)
+
+	req := &compute.AttachInstanceDiskRequest{
+		InstanceId:       instanceID,
+		AttachedDiskSpec: diskSpec,
+	}
+
+	if err := makeAttachDiskRequest(req, meta); err != nil {
+		return err
+	}
+
+	d.SetId(instanceID + "/" + diskSpec.GetDiskId())
+
+	return resourceYandexComputeDiskAttachmentRead(d, meta)
+}
+
+func resourceYandexComputeDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeInstanceDefaultTimeout)
+	defer cancel()
+
+	instanceID := d.Get("instance_id").(string)
+	diskID := d.Get("disk_id").(string)
+
+	instance, err := config.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
+		InstanceId: instanceID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Instance %q", instanceID))
+	}
+
+	attachedDisk := findInstanceSecondaryDisk(instance, diskID)
+	if attachedDisk == nil {
+		log.Printf("[WARN] Disk %q is not attached to Instance %q, removing from state", diskID, instanceID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("device_name", attachedDisk.DeviceName)
+	d.Set("mode", attachedDisk.GetMode().String())
+	d.Set("auto_delete", attachedDisk.AutoDelete)
+
+	return nil
+}
+
+func resourceYandexComputeDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	instanceID := d.Get("instance_id").(string)
+	diskID := d.Get("disk_id").(string)
+
+	mutexKV.Lock(instanceID)
+	defer mutexKV.Unlock(instanceID)
+
+	instance, err := config.sdk.Compute().Instance().Get(config.Context(), &compute.GetInstanceRequest{
+		InstanceId: instanceID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Instance %q", instanceID))
+	}
+
+	if findInstanceSecondaryDisk(instance, diskID) == nil {
+		log.Printf("[DEBUG] Disk %q is already detached from Instance %q", diskID, instanceID)
+		return nil
+	}
+
+	req := &compute.DetachInstanceDiskRequest{
+		InstanceId: instanceID,
+		Disk: &compute.DetachInstanceDiskRequest_DiskId{
+			DiskId: diskID,
+		},
+	}
+
+	if err := makeDetachDiskRequest(req, meta); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Successfully detached disk %q from instance %q", diskID, instanceID)
+	return nil
+}
+
+func resourceYandexComputeDiskAttachmentImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid disk attachment specifier. Expecting {instance-id}/{disk-id}.")
+	}
+
+	if err := d.Set("instance_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("Error setting instance_id: %s", err)
+	}
+	if err := d.Set("disk_id", parts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting disk_id: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findInstanceSecondaryDisk(instance *compute.Instance, diskID string) *compute.AttachedDisk {
+	for _, disk := range instance.SecondaryDisks {
+		if disk.DiskId == diskID {
+			return disk
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+const diskAttachmentResource = "yandex_compute_disk_attachment.foo"
+
+func TestAccComputeDiskAttachment_basic(t *testing.T) {
+	t.Parallel()
+
+	diskName := acctest.RandomWithPrefix("tf-test")
+	instanceName := acctest.RandomWithPrefix("tf-test")
+	var instance compute.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskAttachment_basic(instanceName, diskName, "READ_WRITE", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("yandex_compute_instance.bar", &instance),
+					testAccCheckComputeInstanceAttachedDisks(&instance, diskName),
+					resource.TestCheckResourceAttr(diskAttachmentResource, "device_name", "data"),
+					resource.TestCheckResourceAttr(diskAttachmentResource, "mode", "READ_WRITE"),
+				),
+			},
+			{
+				ResourceName:      diskAttachmentResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing mode re-attaches the disk
+			{
+				Config: testAccComputeDiskAttachment_basic(instanceName, diskName, "READ_ONLY", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("yandex_compute_instance.bar", &instance),
+					testAccCheckComputeInstanceAttachedDisks(&instance, diskName),
+					resource.TestCheckResourceAttr(diskAttachmentResource, "mode", "READ_ONLY"),
+				),
+			},
+			// Removing the attachment detaches the disk but keeps it
+			{
+				Config: testAccComputeDiskAttachment_basic(instanceName, diskName, "READ_ONLY", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("yandex_compute_instance.bar", &instance),
+					testAccCheckComputeInstanceAttachedDisks(&instance),
+					testAccCheckComputeDiskExists("yandex_compute_disk.foo", &compute.Disk{}),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeDiskAttachment_basic(instanceName, diskName, mode string, attached bool) string {
+	attachment := ""
+	if attached {
+		attachment = fmt.Sprintf(`
+resource "yandex_compute_disk_attachment" "foo" {
+  instance_id = "${yandex_compute_instance.bar.id}"
+  disk_id     = "${yandex_compute_disk.foo.id}"
+  device_name = "data"
+  mode        = "%s"
+}
+`, mode)
+	}
+
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_disk" "foo" {
+  name = "%s"
+  size = 4
+  type = "network-hdd"
+  zone = "ru-central1-a"
+}
+
+resource "yandex_compute_instance" "bar" {
+  name        = "%s"
+  platform_id = "standard-v2"
+  zone        = "ru-central1-a"
+
+  resources {
+    cores  = 2
+    memory = 2
+  }
+
+  boot_disk {
+    initialize_params {
+      size     = 4
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.bar-subnet.id}"
+  }
+
+  lifecycle {
+    ignore_changes = [secondary_disk]
+  }
+}
+
+resource "yandex_vpc_network" "foo-network" {}
+
+resource "yandex_vpc_subnet" "bar-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.foo-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+%s`, diskName, instanceName, attachment)
+}
@@ -25,6 +25,11 @@ const (
 	yandexComputeInstanceMoveTimeout          = 1 * time.Minute
 )
 
+const (
+	instanceDesiredStatusRunning = "RUNNING"
+	instanceDesiredStatusStopped = "STOPPED"
+)
+
 func resourceYandexComputeInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceYandexComputeInstanceCreate,
@@ -407,6 +412,13 @@ func resourceYandexComputeInstance() *schema.Resource {
 				Optional: true,
 			},
 
+			"desired_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{instanceDesiredStatusRunning, instanceDesiredStatusStopped}, false),
+			},
+
 			"secondary_disk": {
 				Type:     schema.TypeSet,
 				Set:      hashInstanceSecondaryDisks,
@@ -692,6 +704,12 @@ func resourceYandexComputeInstanceCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Instance creation failed: %s", err)
 	}
 
+	if d.Get("desired_status").(string) == instanceDesiredStatusStopped {
+		if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexComputeInstanceRead(d, meta)
 }
 
@@ -762,6 +780,13 @@ func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("status", strings.ToLower(instance.Status.String()))
 	d.Set("metadata_options", metadataOptions)
 
+	// Transitional statuses (starting, stopping, etc.) keep the previous desired_status
+	// so that an in-flight operation does not show up as a diff.
+	switch instance.Status {
+	case compute.Instance_RUNNING, compute.Instance_STOPPED:
+		d.Set("desired_status", instance.Status.String())
+	}
+
 	hostname, err := parseHostnameFromFQDN(instance.Fqdn)
 	if err != nil {
 		return err
@@ -860,6 +885,8 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 
 	d.Partial(true)
 
+	instanceStopped := instance.Status == compute.Instance_STOPPED
+
 	folderPropName := "folder_id"
 	if d.HasChange(folderPropName) {
 		if !d.Get("allow_recreate").(bool) {
@@ -867,7 +894,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 				return err
 			}
 
-			if instance.Status != compute.Instance_STOPPED {
+			if !instanceStopped {
 				if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
 					return err
 				}
@@ -881,9 +908,13 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			if err := makeInstanceMoveRequest(req, d, meta); err != nil {
 				return err
 			}
+			instanceStopped = true
 
-			if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
-				return err
+			if d.Get("desired_status").(string) != instanceDesiredStatusStopped {
+				if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
+					return err
+				}
+				instanceStopped = false
 			}
 
 		} else {
@@ -1102,6 +1133,10 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange(secDiskPropName) {
 		_, n := d.GetChange(secDiskPropName)
 
+		// Disks may also be attached by yandex_compute_disk_attachment resources.
+		mutexKV.Lock(d.Id())
+		defer mutexKV.Unlock(d.Id())
+
 		// Keep track of disks currently in the instance. Because the yandex_compute_disk resource
 		// can detach disks, it's possible that there are fewer disks currently attached than there
 		// were at the time we ran terraform plan.
@@ -1271,8 +1306,11 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 		if err := ensureAllowStoppingForUpdate(d, properties...); err != nil {
 			return err
 		}
-		if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
-			return err
+		if !instanceStopped {
+			if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
+				return err
+			}
+			instanceStopped = true
 		}
 
 		instanceStoppedAt := time.Now()
@@ -1379,8 +1417,29 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 
 		}
 
-		if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
-			return err
+		if d.Get("desired_status").(string) != instanceDesiredStatusStopped {
+			if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
+				return err
+			}
+			instanceStopped = false
+		}
+	}
+
+	desiredStatusPropName := "desired_status"
+	if d.HasChange(desiredStatusPropName) {
+		switch d.Get(desiredStatusPropName).(string) {
+		case instanceDesiredStatusStopped:
+			if !instanceStopped {
+				if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
+					return err
+				}
+			}
+		case instanceDesiredStatusRunning:
+			if instanceStopped {
+				if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -903,6 +903,53 @@ func TestAccComputeInstance_preemptible(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_desiredStatus(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			// Instance is created stopped
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "STOPPED", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						instanceResource, &instance),
+					testAccCheckComputeInstanceHasStatus(&instance, compute.Instance_STOPPED),
+					resource.TestCheckResourceAttr(instanceResource, "desired_status", "STOPPED"),
+					resource.TestCheckResourceAttr(instanceResource, "status", "stopped"),
+				),
+			},
+			computeInstanceImportStep(),
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "RUNNING", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						instanceResource, &instance),
+					testAccCheckComputeInstanceHasStatus(&instance, compute.Instance_RUNNING),
+					resource.TestCheckResourceAttr(instanceResource, "desired_status", "RUNNING"),
+				),
+			},
+			// Instance stays stopped after an update that requires stopping it
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "STOPPED", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						instanceResource, &instance),
+					testAccCheckComputeInstanceHasResources(&instance, 2, 100, 4),
+					testAccCheckComputeInstanceHasStatus(&instance, compute.Instance_STOPPED),
+				),
+			},
+			computeInstanceImportStep(),
+		},
+	})
+}
+
 func TestAccComputeInstance_update_scheduling_policy(t *testing.T) {
 	t.Parallel()
 
@@ -2003,6 +2050,15 @@ func testAccCheckComputeInstanceIsPreemptible(instance *compute.Instance, expect
 	}
 }
 
+func testAccCheckComputeInstanceHasStatus(instance *compute.Instance, expect compute.Instance_Status) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance.Status != expect {
+			return fmt.Errorf("instance status wrong: expected %s, got %s", expect, instance.Status)
+		}
+		return nil
+	}
+}
+
 func testAccCheckComputeInstanceHasServiceAccount(instance *compute.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if instance.ServiceAccountId == "" {
@@ -4894,3 +4950,45 @@ resource "yandex_compute_instance" "foobar" {
 }
 `, addressName, instanceName)
 }
+
+func testAccComputeInstance_desiredStatus(instance, desiredStatus string, memory int) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_instance" "foobar" {
+  name        = "%s"
+  description = "testAccComputeInstance_desiredStatus"
+  zone        = "ru-central1-a"
+  platform_id = "standard-v2"
+
+  allow_stopping_for_update = true
+  desired_status            = "%s"
+
+  resources {
+    cores  = 2
+    memory = %d
+  }
+
+  boot_disk {
+    initialize_params {
+      size     = 4
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.inst-test-subnet.id}"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+`, instance, desiredStatus, memory)
+}
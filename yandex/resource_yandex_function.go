@@ -4,6 +4,9 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -21,6 +24,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 )
@@ -62,7 +66,8 @@ func resourceYandexFunction() *schema.Resource {
 
 			"user_hash": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"runtime": {
@@ -156,8 +161,27 @@ func resourceYandexFunction() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"zip_filename": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"content.0.zip_filename", "content.0.source_dir"},
+						},
+						"source_dir": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+						},
+						"includes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"excludes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"upload_bucket": {
+							Type:     schema.TypeString,
+							Optional: true,
 						},
 					},
 				},
@@ -429,7 +453,7 @@ func resourceYandexFunctionCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Error expanding labels while creating Yandex Cloud Function: %s", err)
 	}
 
-	versionReq, err := expandLastVersion(d)
+	versionReq, err := expandLastVersion(ctx, d, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -544,7 +568,7 @@ func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	var versionReq *functions.CreateFunctionVersionRequest
 	if len(versionPartialPaths) != 0 {
-		versionReq, err = expandLastVersion(d)
+		versionReq, err = expandLastVersion(ctx, d, config)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -637,6 +661,10 @@ func resourceYandexFunctionDelete(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceYandexFunctionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if err := resourceYandexFunctionCustomizeDiffUserHash(diff); err != nil {
+		return err
+	}
+
 	if diff.HasChange("mounts") || diff.HasChange("storage_mounts") {
 		mounts := diff.Get("mounts").([]interface{})
 		storageMounts := diff.Get("storage_mounts").([]interface{})
@@ -651,6 +679,33 @@ func resourceYandexFunctionCustomizeDiff(_ context.Context, diff *schema.Resourc
 	return nil
 }
 
+// resourceYandexFunctionCustomizeDiffUserHash computes user_hash from the
+// function content when it is not set explicitly, so that a new version is
+// created only when the packaged files actually change.
+func resourceYandexFunctionCustomizeDiffUserHash(diff *schema.ResourceDiff) error {
+	if !diff.GetRawConfig().GetAttr("user_hash").IsNull() {
+		return nil
+	}
+
+	if _, ok := diff.GetOk("content"); !ok {
+		if !diff.NewValueKnown("content") {
+			return diff.SetNewComputed("user_hash")
+		}
+		return fmt.Errorf("user_hash must be set for Yandex Cloud Function deployed from a package")
+	}
+
+	if !diff.NewValueKnown("content.0.zip_filename") || !diff.NewValueKnown("content.0.source_dir") {
+		return diff.SetNewComputed("user_hash")
+	}
+
+	content, err := expandFunctionContent(diff)
+	if err != nil {
+		return fmt.Errorf("Cannot define content for Yandex Cloud Function: %s", err)
+	}
+
+	return diff.SetNew("user_hash", functionContentHash(content))
+}
+
 func mergeFunctionMountsAndStorageMounts(mounts []interface{}, storageMounts []interface{}) interface{} {
 	var (
 		uniqueMounts = make(map[string]struct{})
@@ -706,7 +761,7 @@ func functionStorageMountToMount(storageMount map[string]interface{}) interface{
 	return mount
 }
 
-func expandLastVersion(d *schema.ResourceData) (*functions.CreateFunctionVersionRequest, error) {
+func expandLastVersion(ctx context.Context, d *schema.ResourceData, config *Config) (*functions.CreateFunctionVersionRequest, error) {
 	versionReq := &functions.CreateFunctionVersionRequest{}
 	versionReq.Runtime = d.Get("runtime").(string)
 	versionReq.Entrypoint = d.Get("entrypoint").(string)
@@ -748,14 +803,23 @@ func expandLastVersion(d *schema.ResourceData) (*functions.CreateFunctionVersion
 		}
 		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Package{Package: pkg}
 	} else if _, ok := d.GetOk("content"); ok {
-		content, err := ZipPathToBytes(d.Get("content.0.zip_filename").(string))
+		content, err := expandFunctionContent(d)
 		if err != nil {
 			return nil, fmt.Errorf("Cannot define content for Yandex Cloud Function: %s", err)
 		}
 		if size := len(content); size > versionCreateSourceContentMaxBytes {
-			return nil, fmt.Errorf("Zip archive content size %v exceeds the maximum size %v, use object storage to upload the content", size, versionCreateSourceContentMaxBytes)
+			bucket := d.Get("content.0.upload_bucket").(string)
+			if bucket == "" {
+				return nil, fmt.Errorf("Zip archive content size %v exceeds the maximum size %v, set content.upload_bucket or use object storage to upload the content", size, versionCreateSourceContentMaxBytes)
+			}
+			pkg, err := uploadFunctionContent(ctx, config, bucket, content)
+			if err != nil {
+				return nil, fmt.Errorf("Cannot upload content for Yandex Cloud Function: %s", err)
+			}
+			versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Package{Package: pkg}
+		} else {
+			versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Content{Content: content}
 		}
-		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Content{Content: content}
 	} else {
 		return nil, fmt.Errorf("Package or content option must be present for Yandex Cloud Function")
 	}
@@ -1059,6 +1123,172 @@ func ZipPathToBytes(root string) ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// expandFunctionContent returns the zip archive defined by the content block:
+// either the zip_filename contents or the source_dir packaged deterministically.
+func expandFunctionContent(d interface{ Get(string) interface{} }) ([]byte, error) {
+	if dir := d.Get("content.0.source_dir").(string); dir != "" {
+		includes := expandStringSlice(d.Get("content.0.includes").([]interface{}))
+		excludes := expandStringSlice(d.Get("content.0.excludes").([]interface{}))
+		return zipSourceDir(dir, includes, excludes)
+	}
+	return ZipPathToBytes(d.Get("content.0.zip_filename").(string))
+}
+
+func functionContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// uploadFunctionContent puts the archive into the bucket under a name derived
+// from its hash, so the same contents are never uploaded twice under different names.
+func uploadFunctionContent(ctx context.Context, config *Config, bucket string, content []byte) (*functions.Package, error) {
+	s3Client, err := getS3ClientByKeys(ctx, "", "", config)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := functionContentHash(content)
+	objectName := "yandex-function-" + hash + ".zip"
+	_, err = s3Client.CreateObject(ctx, s3.CreationData{
+		Source: &s3.Source{
+			Type:  s3.SourceTypeContentBase64,
+			Value: base64.StdEncoding.EncodeToString(content),
+		},
+		Bucket:      bucket,
+		Key:         objectName,
+		ACL:         "private",
+		ContentType: "application/zip",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &functions.Package{
+		BucketName: bucket,
+		ObjectName: objectName,
+		Sha256:     hash,
+	}, nil
+}
+
+// zipSourceDir packages files of dir into a zip archive that depends only on
+// file names, permissions and contents: files are added in lexical order and
+// modification times are not recorded.
+//
+// Patterns are matched with filepath.Match against slash-separated paths
+// relative to dir. A pattern without a slash is matched against every path
+// element, so "*.pyc" or "node_modules" apply at any depth. Excluded
+// directories are skipped entirely, includes select files only.
+func zipSourceDir(dir string, includes, excludes []string) ([]byte, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source_dir %q is not a directory", dir)
+	}
+
+	var buffer bytes.Buffer
+	zipWriter := zip.NewWriter(&buffer)
+	files := 0
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		excluded, err := matchSourcePath(rel, excludes)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if excluded {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if excluded {
+			return nil
+		}
+		if len(includes) != 0 {
+			included, err := matchSourcePath(rel, includes)
+			if err != nil {
+				return err
+			}
+			if !included {
+				return nil
+			}
+		}
+
+		header := &zip.FileHeader{
+			Name:   rel,
+			Method: zip.Deflate,
+		}
+		header.SetMode(info.Mode().Perm())
+		entry, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		if _, err := io.Copy(entry, file); err != nil {
+			return err
+		}
+		files++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return nil, err
+	}
+	if files == 0 {
+		return nil, fmt.Errorf("no files to package in source_dir %q", dir)
+	}
+	return buffer.Bytes(), nil
+}
+
+func matchSourcePath(rel string, patterns []string) (bool, error) {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSuffix(filepath.ToSlash(pattern), "/")
+		if !strings.Contains(pattern, "/") {
+			for _, elem := range strings.Split(rel, "/") {
+				ok, err := filepath.Match(pattern, elem)
+				if err != nil {
+					return false, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+				}
+				if ok {
+					return true, nil
+				}
+			}
+			continue
+		}
+
+		ok, err := filepath.Match(pattern, rel)
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func isZipContent(buf []byte) bool {
 	return len(buf) > 3 &&
 		buf[0] == 0x50 && buf[1] == 0x4B &&
@@ -1,12 +1,17 @@
 package yandex
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -1153,3 +1158,97 @@ func testWriteResourceYandexFunction(
 	}
 	fprintfLn(sb, "}")
 }
+
+func TestAccYandexFunction_sourceDir(t *testing.T) {
+	t.Parallel()
+
+	var function functions.Function
+	functionName := acctest.RandomWithPrefix("tf-function")
+
+	config := fmt.Sprintf(`
+resource "yandex_function" "test-function" {
+  name       = "%s"
+  runtime    = "python37"
+  entrypoint = "main.main"
+  memory     = "128"
+  content {
+    source_dir = "test-fixtures/serverless/source"
+    excludes   = ["tests"]
+  }
+}
+`, functionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testYandexFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testYandexFunctionExists(functionResource, &function),
+					resource.TestMatchResourceAttr(functionResource, "user_hash", regexp.MustCompile("^[0-9a-f]{64}$")),
+					resource.TestCheckResourceAttrSet(functionResource, "version"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestZipSourceDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("main.py", "def main(event, context): pass\n")
+	writeFile("lib/util.py", "X = 1\n")
+	writeFile("lib/util.pyc", "compiled")
+	writeFile("node_modules/dep/index.js", "module.exports = {}\n")
+	writeFile("docs/README.md", "docs\n")
+
+	archiveNames := func(content []byte) []string {
+		r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, f := range r.File {
+			names = append(names, f.Name)
+		}
+		return names
+	}
+
+	first, err := zipSourceDir(dir, nil, []string{"*.pyc", "node_modules", "docs/*"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lib/util.py", "main.py"}, archiveNames(first))
+
+	// Modification times must not affect the archive.
+	future := time.Now().Add(time.Hour)
+	assert.NoError(t, os.Chtimes(filepath.Join(dir, "main.py"), future, future))
+	second, err := zipSourceDir(dir, nil, []string{"*.pyc", "node_modules", "docs/*"})
+	assert.NoError(t, err)
+	assert.Equal(t, functionContentHash(first), functionContentHash(second))
+
+	// Changed contents must change the hash.
+	writeFile("lib/util.py", "X = 2\n")
+	third, err := zipSourceDir(dir, nil, []string{"*.pyc", "node_modules", "docs/*"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, functionContentHash(first), functionContentHash(third))
+
+	included, err := zipSourceDir(dir, []string{"*.py"}, []string{"lib"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"main.py"}, archiveNames(included))
+
+	_, err = zipSourceDir(dir, []string{"*.go"}, nil)
+	assert.Error(t, err)
+}
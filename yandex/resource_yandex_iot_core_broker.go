@@ -17,6 +17,10 @@ func resourceYandexIoTCoreBroker() *schema.Resource {
 		Update: resourceYandexIoTCoreBrokerUpdate,
 		Delete: resourceYandexIoTCoreBrokerDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -187,6 +191,14 @@ func resourceYandexIoTCoreBrokerRead(d *schema.ResourceData, meta interface{}) e
 		return handleNotFoundError(err, d, fmt.Sprintf("IoT Broker %q", d.Id()))
 	}
 
+	certsResp, err := config.sdk.IoT().Broker().Broker().ListCertificates(ctx, &iot.ListBrokerCertificatesRequest{BrokerId: d.Id()})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to list certificates of IoT Broker %q: %s", d.Id(), err)
+	}
+	if err := d.Set("certificates", flattenIoTCertificates(certsResp.Certificates)); err != nil {
+		return err
+	}
+
 	return flattenYandexIoTCoreBroker(d, broker)
 }
 
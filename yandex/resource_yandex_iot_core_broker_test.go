@@ -159,6 +159,7 @@ func TestAccYandexIoTCoreBroker_update(t *testing.T) {
 					testYandexIoTCoreStoreBrokerCertificates(authInfo, &broker),
 				),
 			},
+			iotCoreBrokerImportTestStep(),
 		},
 	})
 }
@@ -317,3 +318,11 @@ func testGetBrokerCertificatesByID(config *Config, ID string) (map[string]interf
 	}
 	return res, nil
 }
+
+func iotCoreBrokerImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      iotBrokerResource,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
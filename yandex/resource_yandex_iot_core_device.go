@@ -17,6 +17,10 @@ func resourceYandexIoTCoreDevice() *schema.Resource {
 		Update: resourceYandexIoTCoreDeviceUpdate,
 		Delete: resourceYandexIoTCoreDeviceDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -134,6 +138,9 @@ func flattenYandexIoTCoreDevice(d *schema.ResourceData, device *iot.Device) erro
 	d.Set("name", device.Name)
 	d.Set("description", device.Description)
 	d.Set("created_at", getTimestamp(device.CreatedAt))
+	if err := d.Set("aliases", device.TopicAliases); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -153,6 +160,14 @@ func resourceYandexIoTCoreDeviceRead(d *schema.ResourceData, meta interface{}) e
 		return handleNotFoundError(err, d, fmt.Sprintf("IoT Device %q", d.Id()))
 	}
 
+	certsResp, err := config.sdk.IoT().Devices().Device().ListCertificates(ctx, &iot.ListDeviceCertificatesRequest{DeviceId: d.Id()})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to list certificates of IoT Device %q: %s", d.Id(), err)
+	}
+	if err := d.Set("certificates", flattenIoTCertificates(certsResp.Certificates)); err != nil {
+		return err
+	}
+
 	return flattenYandexIoTCoreDevice(d, device)
 }
 
@@ -186,6 +186,7 @@ func TestAccYandexIoTCoreDevice_update(t *testing.T) {
 					testYandexIoTCoreDeviceContainsAlias(&device, "$devices/{id}/events/updated", "aaa/bbb_updated"),
 				),
 			},
+			iotCoreDeviceImportTestStep(),
 		},
 	})
 }
@@ -409,3 +410,12 @@ func testGetDevicePasswordsByID(config *Config, ID string) (map[string]interface
 	}
 	return res, nil
 }
+
+func iotCoreDeviceImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            iotDeviceResource,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"passwords"},
+	}
+}
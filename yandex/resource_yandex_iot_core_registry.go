@@ -22,6 +22,10 @@ func resourceYandexIoTCoreRegistry() *schema.Resource {
 		Update: resourceYandexIoTCoreRegistryUpdate,
 		Delete: resourceYandexIoTCoreRegistryDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -206,6 +210,14 @@ func resourceYandexIoTCoreRegistryRead(d *schema.ResourceData, meta interface{})
 		return handleNotFoundError(err, d, fmt.Sprintf("IoT Registry %q", d.Id()))
 	}
 
+	certsResp, err := config.sdk.IoT().Devices().Registry().ListCertificates(ctx, &iot.ListRegistryCertificatesRequest{RegistryId: d.Id()})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to list certificates of IoT Registry %q: %s", d.Id(), err)
+	}
+	if err := d.Set("certificates", flattenIoTCertificates(certsResp.Certificates)); err != nil {
+		return err
+	}
+
 	return flattenYandexIoTCoreRegistry(d, registry)
 }
 
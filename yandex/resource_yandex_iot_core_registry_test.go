@@ -173,6 +173,7 @@ func TestAccYandexIoTCoreRegistry_update(t *testing.T) {
 					testYandexIoTCoreNoChangePasswords(authInfo, &registry),
 				),
 			},
+			iotCoreRegistryImportTestStep(),
 		},
 	})
 }
@@ -397,3 +398,12 @@ func testIsEqualSets(s1 map[string]interface{}, s2 map[string]interface{}) bool
 	}
 	return true
 }
+
+func iotCoreRegistryImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            iotRegistryResource,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"passwords"},
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
 )
 
@@ -69,6 +71,40 @@ func commonTestAccLockboxVersion_basic(t *testing.T, versionOptions *lockboxVers
 	})
 }
 
+// Write-only values must be written to the secret, but never appear in the state
+func commonTestAccLockboxVersion_write_only(t *testing.T, versionOptions *lockboxVersionOptions, writeOnlyAttr string) {
+	secretName := "a" + acctest.RandString(10)
+	versionResource := versionOptions.resourceType + ".basic_version"
+	entries := []*lockboxEntryCheck{
+		{Key: "key1", Val: "val1"},
+		{Key: "key2", Val: "val2"},
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// write-only attributes are supported since Terraform 1.11
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLockboxSecretAndVersions(secretName, &lockboxVersionsData{
+					options: versionOptions,
+					versions: []*lockboxVersionData{
+						{ResourceName: "basic_version", Description: "write-only", Entries: entries},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(versionResource, nil),
+					testAccCheckYandexLockboxVersionEntries(versionResource, entries),
+					resource.TestCheckNoResourceAttr(versionResource, writeOnlyAttr),
+				),
+			},
+		},
+	})
+}
+
 func commonTestAccLockboxVersion_add_and_delete(t *testing.T, versionOptions *lockboxVersionOptions) {
 	secretName := "a" + acctest.RandString(10)
 	secretResource := "yandex_lockbox_secret.basic_secret"
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,7 +26,8 @@ func resourceYandexLockboxSecretVersionHashed() *schema.Resource {
 		ReadContext:   resourceYandexLockboxSecretVersionHashedRead,
 		CreateContext: resourceYandexLockboxSecretVersionHashedCreate,
 		DeleteContext: resourceYandexLockboxSecretVersionHashedDelete,
-		// UpdateContext: nil, // updates are not supported, all fields have ForceNew: true
+		// All fields have ForceNew: true, except write-only ones, which never produce a diff.
+		UpdateContext: resourceYandexLockboxSecretVersionHashedUpdate,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexLockboxSecretVersionHashedDefaultTimeout),
@@ -73,6 +75,12 @@ func resourceYandexLockboxSecretVersionHashedRead(ctx context.Context, d *schema
 	return resourceYandexLockboxSecretVersionRead(ctx, d, meta) // same logic as original resource
 }
 
+func resourceYandexLockboxSecretVersionHashedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Write-only values are not stored in state, so changing them alone does nothing.
+	// Use text_value_wo_version_X to trigger a new version.
+	return resourceYandexLockboxSecretVersionHashedRead(ctx, d, meta)
+}
+
 func resourceYandexLockboxSecretVersionHashedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return resourceYandexLockboxSecretVersionDelete(ctx, d, meta) // same logic as original resource
 }
@@ -96,6 +104,21 @@ func addSafeEntries(n int, schemaMap map[string]*schema.Schema) map[string]*sche
 			StateFunc:    hashPayloadTextValue, // hide this sensitive value
 			RequiredWith: []string{keyName(i)},
 		}
+		schemaMap[textValueWoName(i)] = &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			WriteOnly:     true, // never stored in state, so there is nothing to hash
+			ValidateFunc:  validation.StringLenBetween(0, 65536),
+			RequiredWith:  []string{keyName(i)},
+			ConflictsWith: []string{textValueName(i)},
+		}
+		schemaMap[textValueWoVersionName(i)] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{textValueWoName(i)},
+		}
 	}
 
 	return schemaMap
@@ -109,6 +132,14 @@ func textValueName(i int) string {
 	return fmt.Sprintf("text_value_%d", i)
 }
 
+func textValueWoName(i int) string {
+	return fmt.Sprintf("text_value_wo_%d", i)
+}
+
+func textValueWoVersionName(i int) string {
+	return fmt.Sprintf("text_value_wo_version_%d", i)
+}
+
 // We use Scrypt because it's a hash algorithm that lets you configure an arbitrary difficulty,
 // and the result is deterministic (Terraform requires that values don't change between runs).
 // Other options that don't have these features:
@@ -161,10 +192,17 @@ func getVersionPayloadEntry(d *schema.ResourceData, i int) (*lockbox.PayloadEntr
 	}
 	entry := new(lockbox.PayloadEntryChange)
 	entry.SetKey(entryKey.(string))
-	text, exists := d.GetOk(textValueName(i))
-	if !exists {
-		return nil, fmt.Errorf("%s exists but there is no corresponding %s", keyName(i), textValueName(i))
+	if text, exists := d.GetOk(textValueName(i)); exists {
+		entry.SetTextValue(text.(string))
+		return entry, nil
+	}
+	text, err := getWriteOnlyString(d, cty.GetAttrPath(textValueWoName(i)))
+	if err != nil {
+		return nil, err
+	}
+	if text == "" {
+		return nil, fmt.Errorf("%s exists but there is no corresponding %s or %s", keyName(i), textValueName(i), textValueWoName(i))
 	}
-	entry.SetTextValue(text.(string))
+	entry.SetTextValue(text)
 	return entry, nil
 }
@@ -30,6 +30,10 @@ func TestAccLockboxVersionHashed_delete_current_version(t *testing.T) {
 	commonTestAccLockboxVersion_delete_current_version(t, lockboxVersionHashedOptions)
 }
 
+func TestAccLockboxVersionHashed_write_only(t *testing.T) {
+	commonTestAccLockboxVersion_write_only(t, lockboxVersionHashedWriteOnlyOptions, "text_value_wo_1")
+}
+
 func TestAccLockboxVersionHashed_values_hashed_in_state(t *testing.T) {
 	versionOptions := &lockboxVersionOptions{
 		resourceType: "yandex_lockbox_secret_version_hashed",
@@ -99,3 +103,20 @@ func lineForSafeEntry(i int, k string, v string) string {
   text_value_%v = "%v"
 `, i, k, i, v)
 }
+
+var lockboxVersionHashedWriteOnlyOptions = &lockboxVersionOptions{
+	resourceType: "yandex_lockbox_secret_version_hashed",
+	entriesToHcl: linesForWriteOnlySafeEntries,
+}
+
+func linesForWriteOnlySafeEntries(entries []*lockboxEntryCheck) string {
+	result := ""
+	for i, e := range entries {
+		result += fmt.Sprintf(`
+  key_%v                   = "%v"
+  text_value_wo_%v         = "%v"
+  text_value_wo_version_%v = 1
+`, i+1, e.Key, i+1, e.Val, i+1)
+	}
+	return result
+}
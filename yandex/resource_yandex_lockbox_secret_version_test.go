@@ -25,6 +25,10 @@ func TestAccLockboxVersion_delete_current_version(t *testing.T) {
 	commonTestAccLockboxVersion_delete_current_version(t, lockboxVersionOriginalOptions)
 }
 
+func TestAccLockboxVersion_write_only(t *testing.T) {
+	commonTestAccLockboxVersion_write_only(t, lockboxVersionWriteOnlyOptions, "entries.0.text_value_wo")
+}
+
 var lockboxVersionOriginalOptions = &lockboxVersionOptions{
 	resourceType: "yandex_lockbox_secret_version",
 	entriesToHcl: linesForEntries,
@@ -38,6 +42,25 @@ func linesForEntries(entries []*lockboxEntryCheck) string {
 	return result
 }
 
+var lockboxVersionWriteOnlyOptions = &lockboxVersionOptions{
+	resourceType: "yandex_lockbox_secret_version",
+	entriesToHcl: linesForWriteOnlyEntries,
+}
+
+func linesForWriteOnlyEntries(entries []*lockboxEntryCheck) string {
+	result := ""
+	for _, e := range entries {
+		result += fmt.Sprintf(`
+entries {
+    key                   = "%v"
+    text_value_wo         = "%v"
+    text_value_wo_version = 1
+}
+`, e.Key, e.Val)
+	}
+	return result
+}
+
 func lineForEntry(k string, v string) string {
 	return fmt.Sprintf(`
 entries {
@@ -215,6 +215,34 @@ func resourceYandexServerlessContainer() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"build": {
+							Type:          schema.TypeList,
+							MaxItems:      1,
+							Optional:      true,
+							ConflictsWith: []string{"image.0.oci_layout"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"context": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"dockerfile": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"build_args": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"oci_layout": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"image.0.build"},
+						},
 						"work_dir": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -244,6 +272,11 @@ func resourceYandexServerlessContainer() *schema.Resource {
 				},
 			},
 
+			"image_source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -359,6 +392,10 @@ func resourceYandexServerlessContainer() *schema.Resource {
 }
 
 func resourceYandexServerlessContainerCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if err := resourceYandexServerlessContainerCustomizeDiffImageSourceHash(diff); err != nil {
+		return err
+	}
+
 	if diff.HasChange("mounts") || diff.HasChange("storage_mounts") {
 		mounts := diff.Get("mounts").([]interface{})
 		storageMounts := diff.Get("storage_mounts").([]interface{})
@@ -373,6 +410,32 @@ func resourceYandexServerlessContainerCustomizeDiff(ctx context.Context, diff *s
 	return nil
 }
 
+// resourceYandexServerlessContainerCustomizeDiffImageSourceHash tracks the
+// sources of an image built or pushed by the provider, so that the image is
+// rebuilt and a new revision is deployed only when they change.
+func resourceYandexServerlessContainerCustomizeDiffImageSourceHash(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("image") {
+		return diff.SetNewComputed("image_source_hash")
+	}
+
+	source, err := expandServerlessContainerImageSource(diff)
+	if err != nil {
+		return err
+	}
+	if source == nil {
+		if diff.Get("image_source_hash").(string) != "" {
+			return diff.SetNew("image_source_hash", "")
+		}
+		return nil
+	}
+
+	hash, err := source.hash()
+	if err != nil {
+		return fmt.Errorf("Cannot compute image source hash for Yandex Cloud Container: %s", err)
+	}
+	return diff.SetNew("image_source_hash", hash)
+}
+
 func mergeContainerMountsAndStorageMounts(mounts []interface{}, storageMounts []interface{}) interface{} {
 	var (
 		uniqueMounts = make(map[string]struct{})
@@ -444,6 +507,10 @@ func resourceYandexServerlessContainerCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	if err := pushServerlessContainerImage(ctx, d, config); err != nil {
+		return diag.FromErr(err)
+	}
+
 	folderID, err := getFolderID(d, config)
 	if err != nil {
 		return diag.Errorf("Error getting folder ID while creating Yandex Cloud Container: %s", err)
@@ -538,7 +605,7 @@ func resourceYandexServerlessContainerUpdate(ctx context.Context, d *schema.Reso
 	lastRevisionPaths := []string{
 		"memory", "cores", "core_fraction", "execution_timeout", "service_account_id",
 		"secrets", "image", "concurrency", "connectivity", "storage_mounts", "mounts", "log_options", "provision_policy",
-		"runtime", "metadata_options",
+		"runtime", "metadata_options", "image_source_hash",
 	}
 	var revisionUpdatePaths []string
 	for _, p := range lastRevisionPaths {
@@ -555,6 +622,12 @@ func resourceYandexServerlessContainerUpdate(ctx context.Context, d *schema.Reso
 		}
 	}
 
+	if d.HasChange("image_source_hash") {
+		if err := pushServerlessContainerImage(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if len(updatePaths) != 0 {
 		req := containers.UpdateContainerRequest{
 			ContainerId: d.Id(),
@@ -892,6 +965,11 @@ func flattenYandexServerlessContainer(
 			m["args"] = revision.Image.Args.Args
 		}
 		m["environment"] = revision.Image.Environment
+		if !allFields {
+			// The image sources of the resource are not returned by API.
+			m["build"] = d.Get("image.0.build")
+			m["oci_layout"] = d.Get("image.0.oci_layout")
+		}
 
 		d.Set("image", []map[string]interface{}{m})
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"strconv"
 	"strings"
 	"testing"
@@ -131,6 +132,85 @@ func serverlessContainerImportTestStep() resource.TestStep {
 	}
 }
 
+func TestAccYandexServerlessContainer_imageBuild(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("docker"); err != nil {
+		t.Skip("docker is required to build images")
+	}
+
+	var container containers.Container
+	containerName := acctest.RandomWithPrefix("tf-container")
+
+	config := func(greeting string) string {
+		return fmt.Sprintf(`
+resource "yandex_container_registry" "test-registry" {
+  name = "%[1]s"
+}
+
+resource "yandex_container_repository" "test-repository" {
+  name = "${yandex_container_registry.test-registry.id}/app"
+}
+
+resource "yandex_iam_service_account" "test-account" {
+  name = "%[1]s"
+}
+
+resource "yandex_container_registry_iam_binding" "puller" {
+  registry_id = yandex_container_registry.test-registry.id
+  role        = "container-registry.images.puller"
+  members     = ["serviceAccount:${yandex_iam_service_account.test-account.id}"]
+}
+
+resource "yandex_serverless_container" "test-container" {
+  name               = "%[1]s"
+  memory             = 128
+  service_account_id = yandex_iam_service_account.test-account.id
+  image {
+    url = "cr.yandex/${yandex_container_repository.test-repository.name}:latest"
+    build {
+      context = "test-fixtures/serverless/container"
+      build_args = {
+        GREETING = "%[2]s"
+      }
+    }
+  }
+
+  depends_on = [yandex_container_registry_iam_binding.puller]
+}
+`, containerName, greeting)
+	}
+
+	var firstRevision, secondRevision containers.Revision
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testYandexServerlessContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexServerlessContainerExists(serverlessContainerResource, &container),
+					testYandexServerlessContainerRevisionExists(serverlessContainerResource, &firstRevision),
+					resource.TestCheckResourceAttrSet(serverlessContainerResource, "image_source_hash"),
+					resource.TestCheckResourceAttrSet(serverlessContainerResource, "image.0.digest"),
+				),
+			},
+			{
+				Config:   config("hello"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("bye"),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexServerlessContainerRevisionExists(serverlessContainerResource, &secondRevision),
+					testYandexServerlessContainerRevisionChanged(&firstRevision, &secondRevision, true),
+				),
+			},
+		},
+	})
+}
+
 func basicYandexServerlessContainerTestStep(containerName string, containerDesc string, memory int, image string, container *containers.Container, revision *containers.Revision, revisionChanged bool) resource.TestStep {
 	var newRevision containers.Revision
 	return resource.TestStep{
@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -21,6 +22,12 @@ func resourceYandexStorageObject() *schema.Resource {
 		UpdateContext: resourceYandexStorageObjectUpdate,
 		DeleteContext: resourceYandexStorageObjectDelete,
 
+		CustomizeDiff: resourceYandexStorageObjectCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexStorageObjectImport,
+		},
+
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +89,57 @@ func resourceYandexStorageObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"website_redirect": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateStorageObjectMetadataKeys,
+			},
+
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{s3.ServerSideEncryptionAwsKms}, false),
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"server_side_encryption"},
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -144,6 +202,27 @@ func resourceYandexStorageObjectCreate(ctx context.Context, d *schema.ResourceDa
 	if v, ok := d.GetOk("content_type"); ok {
 		data.ContentType = v.(string)
 	}
+	if v, ok := d.GetOk("cache_control"); ok {
+		data.CacheControl = v.(string)
+	}
+	if v, ok := d.GetOk("content_disposition"); ok {
+		data.ContentDisposition = v.(string)
+	}
+	if v, ok := d.GetOk("content_encoding"); ok {
+		data.ContentEncoding = v.(string)
+	}
+	if v, ok := d.GetOk("website_redirect"); ok {
+		data.WebsiteRedirect = v.(string)
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		data.Metadata = s3.NewMetadata(v)
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		data.ServerSideEncryption = v.(string)
+	}
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		data.KMSKeyID = v.(string)
+	}
 	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
 		data.ObjectLockLegalHoldStatus = v.(string)
 	}
@@ -198,6 +277,17 @@ func resourceYandexStorageObjectRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set("content_type", object.ContentType)
+	d.Set("cache_control", object.CacheControl)
+	d.Set("content_disposition", object.ContentDisposition)
+	d.Set("content_encoding", object.ContentEncoding)
+	d.Set("website_redirect", object.WebsiteRedirect)
+	d.Set("server_side_encryption", object.ServerSideEncryption)
+	d.Set("kms_key_id", object.KMSKeyID)
+	d.Set("etag", object.ETag)
+	d.Set("version_id", object.VersionID)
+	if err := d.Set("metadata", object.Metadata); err != nil {
+		return diag.Errorf("error setting S3 Storage Object metadata: %s", err)
+	}
 	if object.ObjectLockLegalHoldStatus != nil {
 		d.Set("object_lock_legal_hold_status", *object.ObjectLockLegalHoldStatus)
 	}
@@ -210,9 +300,32 @@ func resourceYandexStorageObjectRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("error setting S3 Storage Object Tagging: %s", err)
 	}
 
+	acl, err := s3Client.GetObjectACL(ctx, bucket, key)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// "bucket-owner-full-control" is indistinguishable from "private" for objects of the bucket owner.
+	if acl != "" && !(acl == "private" && d.Get("acl").(string) == "bucket-owner-full-control") {
+		d.Set("acl", acl)
+	}
+
 	return nil
 }
 
+// resourceYandexStorageObjectImport accepts ID in "bucket/key" format.
+func resourceYandexStorageObjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	bucket, key, ok := strings.Cut(d.Id(), "/")
+	if !ok || bucket == "" || key == "" {
+		return nil, fmt.Errorf("invalid storage object ID %q, expected format is \"bucket/key\"", d.Id())
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+	d.SetId(key)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexStorageObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if hasObjectContentChanged(d) {
 		return resourceYandexStorageObjectCreate(ctx, d, meta)
@@ -249,13 +362,37 @@ func resourceYandexStorageObjectUpdate(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func hasObjectContentChanged(d *schema.ResourceData) bool {
+// resourceYandexStorageObjectCustomizeDiff marks attributes assigned by Object Storage
+// as unknown when the object is going to be uploaded again.
+func resourceYandexStorageObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !hasObjectContentChanged(d) {
+		return nil
+	}
+
+	for _, key := range []string{"etag", "version_id"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasObjectContentChanged reports whether the object must be uploaded again,
+// since its content and system metadata can not be changed in place.
+func hasObjectContentChanged(d interface{ HasChange(string) bool }) bool {
 	for _, key := range []string{
 		"source",
 		"source_hash",
 		"content",
 		"content_base64",
 		"content_type",
+		"cache_control",
+		"content_disposition",
+		"content_encoding",
+		"website_redirect",
+		"metadata",
+		"server_side_encryption",
+		"kms_key_id",
 	} {
 		if d.HasChange(key) {
 			return true
@@ -265,6 +402,15 @@ func hasObjectContentChanged(d *schema.ResourceData) bool {
 	return false
 }
 
+func validateStorageObjectMetadataKeys(v interface{}, k string) (ws []string, errs []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errs = append(errs, fmt.Errorf("%s: metadata key %q must be lowercase, Object Storage returns metadata keys in lowercase", k, key))
+		}
+	}
+	return
+}
+
 func resourceYandexStorageObjectACLUpdate(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
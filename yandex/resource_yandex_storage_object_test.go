@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -239,6 +240,40 @@ func TestAccStorageObject_contentTypeText(t *testing.T) {
 	})
 }
 
+func TestAccStorageObject_headersAndMetadata(t *testing.T) {
+	var obj awsS3.GetObjectOutput
+	resourceName := "yandex_storage_object.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectConfigHeaders(rInt, "max-age=60", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+					testAccCheckStorageObjectHeaders(&obj, "max-age=60", map[string]string{"build-id": "v1"}),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=60"),
+					resource.TestCheckResourceAttr(resourceName, "content_disposition", "inline"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.build-id", "v1"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+			{
+				Config: testAccStorageObjectConfigHeaders(rInt, "no-cache", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+					testAccCheckStorageObjectHeaders(&obj, "no-cache", map[string]string{"build-id": "v2"}),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "no-cache"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.build-id", "v2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageObject_updateAcl(t *testing.T) {
 	var obj awsS3.GetObjectOutput
 	rInt := acctest.RandInt()
@@ -267,6 +302,39 @@ func TestAccStorageObject_updateAcl(t *testing.T) {
 	})
 }
 
+func TestAccStorageObject_import(t *testing.T) {
+	var obj awsS3.GetObjectOutput
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_object.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectAclPreConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["bucket"] + "/" + rs.Primary.Attributes["key"], nil
+				},
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key", "content"},
+			},
+		},
+	})
+}
+
 func TestAccStorageObject_ObjectLockNone(t *testing.T) {
 	var obj awsS3.GetObjectOutput
 	rInt := acctest.RandInt()
@@ -473,6 +541,18 @@ func testAccCheckStorageObjectContentType(obj *awsS3.GetObjectOutput, want strin
 	}
 }
 
+func testAccCheckStorageObjectHeaders(obj *awsS3.GetObjectOutput, cacheControl string, metadata map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(obj.CacheControl); got != cacheControl {
+			return fmt.Errorf("wrong result cache_control %q; want %q", got, cacheControl)
+		}
+		if got := s3.S3MetadataToRaw(obj.Metadata); !reflect.DeepEqual(got, metadata) {
+			return fmt.Errorf("wrong result metadata %v; want %v", got, metadata)
+		}
+		return nil
+	}
+}
+
 func testAccCheckStorageObjectLegalHoldStatus(obj *awsS3.GetObjectOutput, want string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if got := aws.StringValue(obj.ObjectLockLegalHoldStatus); got != want {
@@ -680,6 +760,31 @@ resource "yandex_storage_object" "test" {
 	return bucketConfig + objectConfig
 }
 
+func testAccStorageObjectConfigHeaders(randInt int, cacheControl, buildID string) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	objectConfig := fmt.Sprintf(`
+resource "yandex_storage_object" "test" {
+	bucket = "${yandex_storage_bucket.test.bucket}"
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	key                 = "index.html"
+	content             = "<html></html>"
+	content_type        = "text/html"
+	cache_control       = "%[1]s"
+	content_disposition = "inline"
+
+	metadata = {
+		build-id = "%[2]s"
+	}
+}
+`, cacheControl, buildID)
+
+	return bucketConfig + objectConfig
+}
+
 func testAccStorageObjectAclPreConfig(randInt int) string {
 	bucketConfig := newBucketConfigBuilder(randInt).asAdmin().render()
 
@@ -0,0 +1,435 @@
+package yandex
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+const defaultStorageObjectsSyncConcurrency = 16
+
+func resourceYandexStorageObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceYandexStorageObjectsSyncCreate,
+		ReadContext:   resourceYandexStorageObjectsSyncRead,
+		UpdateContext: resourceYandexStorageObjectsSyncUpdate,
+		DeleteContext: resourceYandexStorageObjectsSyncDelete,
+
+		CustomizeDiff: resourceYandexStorageObjectsSyncCustomizeDiff,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"includes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"excludes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"access_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"secret_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "private",
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultStorageObjectsSyncConcurrency,
+				ValidateFunc: validation.IntBetween(1, 128),
+			},
+
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// storageSyncFile is a local file to be uploaded as an object.
+type storageSyncFile struct {
+	path string
+	md5  string
+}
+
+// scanStorageSyncDir walks the source directory and returns the files to
+// upload by object key.
+func scanStorageSyncDir(dir, prefix string, includes, excludes []string) (map[string]storageSyncFile, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source_dir %q is not a directory", dir)
+	}
+
+	files := make(map[string]storageSyncFile)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		excluded, err := matchSourcePath(rel, excludes)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if excluded {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if excluded {
+			return nil
+		}
+		if len(includes) != 0 {
+			included, err := matchSourcePath(rel, includes)
+			if err != nil {
+				return err
+			}
+			if !included {
+				return nil
+			}
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			if info, err = os.Stat(path); err != nil {
+				return err
+			}
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		sum, err := fileMD5(path)
+		if err != nil {
+			return err
+		}
+		files[prefix+rel] = storageSyncFile{path: path, md5: sum}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("error reading file %q: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// detectStorageContentType detects the content type of the file by its
+// extension, falling back to sniffing the first 512 bytes of its content.
+func detectStorageContentType(path string, overrides map[string]string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if contentType, ok := overrides[ext]; ok {
+		return contentType, nil
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", fmt.Errorf("error reading file %q: %w", path, err)
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+func scanStorageSyncDirFromConfig(d interface{ Get(string) interface{} }) (map[string]storageSyncFile, error) {
+	return scanStorageSyncDir(
+		d.Get("source_dir").(string),
+		d.Get("prefix").(string),
+		expandStringSlice(d.Get("includes").([]interface{})),
+		expandStringSlice(d.Get("excludes").([]interface{})),
+	)
+}
+
+func storageSyncFileHashes(files map[string]storageSyncFile) map[string]interface{} {
+	out := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		out[key] = file.md5
+	}
+	return out
+}
+
+// resourceYandexStorageObjectsSyncCustomizeDiff compares the hashes of the local
+// files with the ones in the state, so that changed files show up in the plan.
+func resourceYandexStorageObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"source_dir", "prefix", "includes", "excludes"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+
+	files, err := scanStorageSyncDirFromConfig(d)
+	if err != nil {
+		return err
+	}
+
+	hashes := storageSyncFileHashes(files)
+	if reflect.DeepEqual(hashes, d.Get("files").(map[string]interface{})) {
+		return nil
+	}
+	return d.SetNew("files", hashes)
+}
+
+func resourceYandexStorageObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string) + "/" + d.Get("prefix").(string))
+
+	var diags diag.Diagnostics
+	if err := syncStorageObjects(ctx, d, meta, map[string]interface{}{}, true); err != nil {
+		if len(d.Get("files").(map[string]interface{})) == 0 {
+			d.SetId("")
+			return diag.FromErr(err)
+		}
+		// Failing the creation would taint the resource and upload all the
+		// files again. Instead, only the uploaded files are stored, so the
+		// next plan shows the rest of them as changes.
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Not all files were uploaded to the storage bucket",
+			Detail:   fmt.Sprintf("%s\n\nThe remaining files will be uploaded on the next apply.", err),
+		})
+	}
+
+	return append(diags, resourceYandexStorageObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceYandexStorageObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	keys, err := s3Client.ListObjectKeys(ctx, bucket, d.Get("prefix").(string))
+	if err != nil {
+		if s3.IsErr(err, s3.NoSuchBucket) {
+			log.Printf("[WARN] Storage bucket %q not found, removing objects sync %q from state", bucket, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Objects removed outside of Terraform are dropped from the state to be
+	// uploaded again. Contents are not compared, since ETag of encrypted
+	// objects is not an MD5 hash of the content.
+	files := d.Get("files").(map[string]interface{})
+	for key := range files {
+		if _, ok := keys[key]; !ok {
+			log.Printf("[DEBUG] Storage object %q not found in bucket %q", key, bucket)
+			delete(files, key)
+		}
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return diag.Errorf("error setting files: %s", err)
+	}
+	return nil
+}
+
+func resourceYandexStorageObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldFiles, _ := d.GetChange("files")
+	uploadAll := d.HasChanges("acl", "cache_control", "content_types")
+
+	if err := syncStorageObjects(ctx, d, meta, oldFiles.(map[string]interface{}), uploadAll); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexStorageObjectsSyncRead(ctx, d, meta)
+}
+
+// syncStorageObjects uploads local files which differ from the given state and
+// deletes objects of files which no longer exist locally. The files attribute is
+// always set to what was actually synced, even if some requests failed.
+func syncStorageObjects(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+	synced map[string]interface{},
+	uploadAll bool,
+) error {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return fmt.Errorf("error getting storage client: %s", err)
+	}
+
+	files, err := scanStorageSyncDirFromConfig(d)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	contentTypes := make(map[string]string)
+	for ext, contentType := range d.Get("content_types").(map[string]interface{}) {
+		contentTypes[strings.ToLower(ext)] = contentType.(string)
+	}
+
+	var uploads []s3.CreationData
+	for key, file := range files {
+		if !uploadAll && synced[key] == file.md5 {
+			continue
+		}
+
+		contentType, err := detectStorageContentType(file.path, contentTypes)
+		if err != nil {
+			return err
+		}
+		uploads = append(uploads, s3.CreationData{
+			Source: &s3.Source{
+				Type:  s3.SourceTypeFile,
+				Value: file.path,
+			},
+			Bucket:       bucket,
+			Key:          key,
+			ACL:          d.Get("acl").(string),
+			ContentType:  contentType,
+			CacheControl: d.Get("cache_control").(string),
+		})
+	}
+	sort.Slice(uploads, func(i, j int) bool { return uploads[i].Key < uploads[j].Key })
+
+	var deletes []string
+	for key := range synced {
+		if _, ok := files[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+	sort.Strings(deletes)
+
+	log.Printf("[DEBUG] Syncing %q to bucket %q: %d objects to upload, %d objects to delete",
+		d.Get("source_dir").(string), bucket, len(uploads), len(deletes))
+
+	defer func() {
+		if err := d.Set("files", synced); err != nil {
+			log.Printf("[ERROR] Unable to set files of storage objects sync %q: %s", d.Id(), err)
+		}
+	}()
+
+	err = s3Client.UploadObjects(ctx, uploads, d.Get("concurrency").(int), func(key string) {
+		synced[key] = files[key].md5
+	})
+	if err != nil {
+		return err
+	}
+
+	return s3Client.DeleteObjects(ctx, bucket, deletes, func(key string) {
+		delete(synced, key)
+	})
+}
+
+func resourceYandexStorageObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	err = s3Client.DeleteObjects(ctx, d.Get("bucket").(string), keys, func(string) {})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+func writeStorageSyncTestFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func TestScanStorageSyncDir(t *testing.T) {
+	dir := t.TempDir()
+	writeStorageSyncTestFiles(t, dir, map[string]string{
+		"index.html":            "<html></html>",
+		"assets/app.js":         "console.log(1)",
+		"assets/app.js.map":     "{}",
+		"node_modules/x/x.js":   "x",
+		"assets/img/logo.svg":   "<svg/>",
+		"assets/img/.DS_Store":  "",
+		"assets/fonts/font.ttf": "font",
+	})
+
+	files, err := scanStorageSyncDir(dir, "site/", nil, []string{"node_modules", "*.map", ".DS_Store"})
+	require.NoError(t, err)
+
+	var keys []string
+	for key := range files {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{
+		"site/index.html",
+		"site/assets/app.js",
+		"site/assets/img/logo.svg",
+		"site/assets/fonts/font.ttf",
+	}, keys)
+	// md5("<html></html>")
+	assert.Equal(t, "c83301425b2ad1d496473a5ff3d9ecca", files["site/index.html"].md5)
+
+	files, err = scanStorageSyncDir(dir, "", []string{"assets/*"}, nil)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Contains(t, files, "assets/app.js")
+	assert.Contains(t, files, "assets/app.js.map")
+
+	_, err = scanStorageSyncDir(filepath.Join(dir, "index.html"), "", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestDetectStorageContentType(t *testing.T) {
+	dir := t.TempDir()
+	writeStorageSyncTestFiles(t, dir, map[string]string{
+		"index.html": "<html></html>",
+		"app.JS":     "console.log(1)",
+		"LICENSE":    "plain text",
+		"font.woff3": "\x00\x01\x02",
+	})
+
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      string
+	}{
+		{name: "index.html", want: "text/html; charset=utf-8"},
+		{name: "app.JS", overrides: map[string]string{".js": "application/javascript"}, want: "application/javascript"},
+		{name: "LICENSE", want: "text/plain; charset=utf-8"},
+		{name: "font.woff3", want: "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := detectStorageContentType(filepath.Join(dir, tt.name), tt.overrides)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStorageObjectsSyncCreatePartialUpload(t *testing.T) {
+	// Custom CA bundle is not supported with the test server transport.
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	var mu sync.Mutex
+	uploaded := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+		switch {
+		case r.Method == http.MethodPut && key == "c.txt":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)
+		case r.Method == http.MethodPut:
+			uploaded[key] = true
+		case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+			fmt.Fprint(w, `<ListBucketResult>`)
+			for key := range uploaded {
+				fmt.Fprintf(w, `<Contents><Key>%s</Key></Contents>`, key)
+			}
+			fmt.Fprint(w, `<IsTruncated>false</IsTruncated></ListBucketResult>`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := s3.NewClient(context.Background(), "access-key", "secret-key", nil, server.URL)
+	require.NoError(t, err)
+	client.S3().Client.Config.S3ForcePathStyle = aws.Bool(true)
+	client.S3().Client.Config.MaxRetries = aws.Int(0)
+
+	dir := t.TempDir()
+	writeStorageSyncTestFiles(t, dir, map[string]string{
+		"a.txt": "a",
+		"b.txt": "b",
+		"c.txt": "c",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceYandexStorageObjectsSync().Schema, map[string]interface{}{
+		"bucket":      "bucket",
+		"source_dir":  dir,
+		"concurrency": 1,
+	})
+	diags := resourceYandexStorageObjectsSyncCreate(context.Background(), d, &Config{defaultS3Client: client})
+
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, "c.txt")
+	assert.Equal(t, "bucket/", d.Id())
+	assert.Equal(t, map[string]interface{}{
+		"a.txt": "0cc175b9c0f1b6a831c399e269772661",
+		"b.txt": "92eb5ffee6ae2fec3ad71c777531578f",
+	}, d.Get("files"))
+}
+
+func TestAccStorageObjectsSync_basic(t *testing.T) {
+	resourceName := "yandex_storage_objects_sync.test"
+	rInt := acctest.RandInt()
+
+	dir := t.TempDir()
+	writeStorageSyncTestFiles(t, dir, map[string]string{
+		"index.html":    "<html></html>",
+		"assets/app.js": "console.log(1)",
+		"assets/old.js": "console.log(0)",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectsSyncConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					testAccCheckStorageObjectsSyncObject(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckStorageObjectsSyncObject(resourceName, "site/assets/old.js", "text/javascript; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					require.NoError(t, os.Remove(filepath.Join(dir, "assets", "old.js")))
+					writeStorageSyncTestFiles(t, dir, map[string]string{"assets/app.js": "console.log(2)"})
+				},
+				Config: testAccStorageObjectsSyncConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckStorageObjectsSyncObject(resourceName, "site/assets/app.js", "text/javascript; charset=utf-8"),
+					testAccCheckStorageObjectsSyncNoObject(resourceName, "site/assets/old.js"),
+				),
+			},
+			{
+				Config:   testAccStorageObjectsSyncConfig(rInt, dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccStorageObjectsSyncHeadObject(s *terraform.State, n, key string) (*awsS3.HeadObjectOutput, error) {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return nil, fmt.Errorf("not found: %s", n)
+	}
+
+	s3Client, err := getS3ClientByKeys(
+		context.TODO(),
+		rs.Primary.Attributes["access_key"],
+		rs.Primary.Attributes["secret_key"],
+		testAccProvider.Meta().(*Config),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3Client.S3().HeadObject(&awsS3.HeadObjectInput{
+		Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+		Key:    aws.String(key),
+	})
+}
+
+func testAccCheckStorageObjectsSyncObject(n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		out, err := testAccStorageObjectsSyncHeadObject(s, n, key)
+		if err != nil {
+			return fmt.Errorf("storage object %q error: %s", key, err)
+		}
+		if got := aws.StringValue(out.ContentType); got != contentType {
+			return fmt.Errorf("wrong content type of %q: %q; want %q", key, got, contentType)
+		}
+		return nil
+	}
+}
+
+func testAccCheckStorageObjectsSyncNoObject(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, err := testAccStorageObjectsSyncHeadObject(s, n, key); err == nil {
+			return fmt.Errorf("storage object %q still exists", key)
+		}
+		return nil
+	}
+}
+
+func testAccCheckStorageObjectsSyncDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_storage_objects_sync" {
+			continue
+		}
+
+		for attr := range rs.Primary.Attributes {
+			key, ok := strings.CutPrefix(attr, "files.")
+			if !ok || key == "%" {
+				continue
+			}
+			if _, err := testAccStorageObjectsSyncHeadObject(s, "yandex_storage_objects_sync.test", key); err == nil {
+				return fmt.Errorf("storage object %q still exists", key)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccStorageObjectsSyncConfig(randInt int, dir string) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	syncConfig := fmt.Sprintf(`
+resource "yandex_storage_objects_sync" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+	prefix = "site/"
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	source_dir    = "%[1]s"
+	cache_control = "max-age=60"
+	content_types = {
+		".js" = "text/javascript; charset=utf-8"
+	}
+}
+`, dir)
+
+	return bucketConfig + syncConfig
+}
@@ -27,7 +27,7 @@ func resourceYandexYDBTableChangefeed() *schema.Resource {
 func resourceYandexYDBTableChangefeedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -39,7 +39,7 @@ func resourceYandexYDBTableChangefeedCreate(ctx context.Context, d *schema.Resou
 func resourceYandexYDBTableChangefeedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -51,7 +51,7 @@ func resourceYandexYDBTableChangefeedRead(ctx context.Context, d *schema.Resourc
 func resourceYandexYDBTableChangefeedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -63,7 +63,7 @@ func resourceYandexYDBTableChangefeedUpdate(ctx context.Context, d *schema.Resou
 func resourceYandexYDBTableChangefeedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
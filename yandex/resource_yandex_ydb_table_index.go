@@ -27,7 +27,7 @@ func resourceYandexYDBTableIndex() *schema.Resource {
 func resourceYandexYDBTableIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -39,7 +39,7 @@ func resourceYandexYDBTableIndexCreate(ctx context.Context, d *schema.ResourceDa
 func resourceYandexYDBTableIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -51,7 +51,7 @@ func resourceYandexYDBTableIndexRead(ctx context.Context, d *schema.ResourceData
 func resourceYandexYDBTableIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -63,7 +63,7 @@ func resourceYandexYDBTableIndexUpdate(ctx context.Context, d *schema.ResourceDa
 func resourceYandexYDBTableIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
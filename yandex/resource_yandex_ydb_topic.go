@@ -49,7 +49,7 @@ func resourceYandexYDBTopic() *schema.Resource {
 func resourceYandexYDBTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -61,7 +61,7 @@ func resourceYandexYDBTopicCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceYandexYDBTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -73,7 +73,7 @@ func resourceYandexYDBTopicRead(ctx context.Context, d *schema.ResourceData, met
 func resourceYandexYDBTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
@@ -85,7 +85,7 @@ func resourceYandexYDBTopicUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceYandexYDBTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
+		token, err := config.createIAMToken(ctx)
 		if err != nil {
 			return auth.YdbCredentials{}, err
 		}
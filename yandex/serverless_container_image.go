@@ -0,0 +1,672 @@
+package yandex
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	serverlessContainerImagePlatform = "linux/amd64"
+
+	ociImageIndexMediaType       = "application/vnd.oci.image.index.v1+json"
+	ociImageManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestListMediaType  = "application/vnd.docker.distribution.manifest.list.v2+json"
+	dockerImageManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+type containerImageSource struct {
+	buildContext string
+	dockerfile   string
+	buildArgs    map[string]string
+	ociLayout    string
+}
+
+func expandServerlessContainerImageSource(d interface{ Get(string) interface{} }) (*containerImageSource, error) {
+	if v := d.Get("image.0.oci_layout").(string); v != "" {
+		return &containerImageSource{ociLayout: v}, nil
+	}
+
+	builds := d.Get("image.0.build").([]interface{})
+	if len(builds) == 0 || builds[0] == nil {
+		return nil, nil
+	}
+	build := builds[0].(map[string]interface{})
+
+	buildArgs, err := expandLabels(build["build_args"])
+	if err != nil {
+		return nil, fmt.Errorf("Cannot define image build arguments for Yandex Cloud Container: %s", err)
+	}
+
+	return &containerImageSource{
+		buildContext: build["context"].(string),
+		dockerfile:   build["dockerfile"].(string),
+		buildArgs:    buildArgs,
+	}, nil
+}
+
+// hash returns a digest of everything the image is built from: the OCI layout
+// tarball or the files of the build context together with the build options.
+func (s *containerImageSource) hash() (string, error) {
+	h := sha256.New()
+
+	if s.ociLayout != "" {
+		file, err := os.Open(s.ociLayout)
+		if err != nil {
+			return "", err
+		}
+		defer file.Close()
+
+		if _, err := io.Copy(h, file); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	fmt.Fprintf(h, "dockerfile=%s\n", s.dockerfile)
+	fmt.Fprintf(h, "platform=%s\n", serverlessContainerImagePlatform)
+	keys := make([]string, 0, len(s.buildArgs))
+	for k := range s.buildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(h, "build-arg=%s=%s\n", k, s.buildArgs[k])
+	}
+
+	ignore, err := readDockerignore(s.buildContext)
+	if err != nil {
+		return "", err
+	}
+	dockerfile := s.dockerfile
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+
+	err = filepath.Walk(s.buildContext, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(s.buildContext, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		// The Dockerfile and .dockerignore are used by the build even if
+		// they are excluded from the context.
+		if rel != "." && rel != filepath.ToSlash(filepath.Clean(dockerfile)) && rel != ".dockerignore" && ignore.matches(rel) {
+			if info.IsDir() && !ignore.hasExceptions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		fmt.Fprintf(h, "file=%s mode=%o\n", rel, info.Mode().Perm())
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(h, file)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// dockerignorePattern is a single line of the .dockerignore file.
+type dockerignorePattern struct {
+	re        *regexp.Regexp
+	exception bool
+}
+
+// dockerignore holds the patterns of the build context's .dockerignore file,
+// excluding files from the context the same way `docker build` does.
+type dockerignore []dockerignorePattern
+
+func readDockerignore(buildContext string) (dockerignore, error) {
+	data, err := os.ReadFile(filepath.Join(buildContext, ".dockerignore"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns dockerignore
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var pattern dockerignorePattern
+		if strings.HasPrefix(line, "!") {
+			pattern.exception = true
+			line = strings.TrimSpace(line[1:])
+		}
+		line = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(line)), "/")
+		if line == "" || line == "." {
+			continue
+		}
+		pattern.re, err = dockerignorePatternRegexp(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid .dockerignore pattern %q: %s", line, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// dockerignorePatternRegexp converts a .dockerignore pattern to a regular
+// expression: "**" matches any number of directories, "*" and "?" do not
+// match the path separator.
+func dockerignorePatternRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**/") {
+				sb.WriteString("(.*/)?")
+				i += 2
+			} else if strings.HasPrefix(pattern[i:], "**") {
+				sb.WriteString(".*")
+				i++
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated character class")
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// matches reports whether the slash separated path relative to the build
+// context is excluded. A pattern matching a directory excludes everything
+// inside it, and the last matching pattern wins.
+func (d dockerignore) matches(path string) bool {
+	excluded := false
+	for _, pattern := range d {
+		if pattern.exception != excluded {
+			continue
+		}
+		for p := path; p != "."; p = dockerignoreParent(p) {
+			if pattern.re.MatchString(p) {
+				excluded = !pattern.exception
+				break
+			}
+		}
+	}
+	return excluded
+}
+
+// hasExceptions reports whether some pattern re-includes files, so that
+// excluded directories still have to be walked.
+func (d dockerignore) hasExceptions() bool {
+	for _, pattern := range d {
+		if pattern.exception {
+			return true
+		}
+	}
+	return false
+}
+
+func dockerignoreParent(path string) string {
+	if i := strings.LastIndexByte(path, '/'); i >= 0 {
+		return path[:i]
+	}
+	return "."
+}
+
+// pushServerlessContainerImage builds the image if needed and pushes it to
+// image.0.url, so that the following revision deployment picks it up.
+func pushServerlessContainerImage(ctx context.Context, d *schema.ResourceData, config *Config) error {
+	source, err := expandServerlessContainerImageSource(d)
+	if err != nil || source == nil {
+		return err
+	}
+
+	imageURL := d.Get("image.0.url").(string)
+
+	layout := source.ociLayout
+	if layout == "" {
+		tmpDir, err := os.MkdirTemp("", "yandex-serverless-container")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		layout = filepath.Join(tmpDir, "image.tar")
+		if err := buildContainerImage(ctx, source, layout); err != nil {
+			return fmt.Errorf("Error while building image for Yandex Cloud Container: %s", err)
+		}
+	}
+
+	token, err := config.getIAMToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	digest, err := pushOCILayout(ctx, layout, imageURL, token)
+	if err != nil {
+		return fmt.Errorf("Error while pushing image %q for Yandex Cloud Container: %s", imageURL, err)
+	}
+	log.Printf("[DEBUG] Pushed image %q with digest %s", imageURL, digest)
+	return nil
+}
+
+// buildContainerImage runs `docker buildx build` exporting the image as an OCI
+// layout tarball. Provenance attestations are disabled since they produce
+// additional manifests not supported by Serverless Containers.
+func buildContainerImage(ctx context.Context, source *containerImageSource, dest string) error {
+	args := []string{
+		"buildx", "build",
+		"--platform", serverlessContainerImagePlatform,
+		"--provenance=false",
+		"--output", "type=oci,dest=" + dest,
+	}
+	if source.dockerfile != "" {
+		args = append(args, "--file", filepath.Join(source.buildContext, source.dockerfile))
+	}
+	keys := make([]string, 0, len(source.buildArgs))
+	for k := range source.buildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--build-arg", k+"="+source.buildArgs[k])
+	}
+	args = append(args, source.buildContext)
+
+	var output bytes.Buffer
+	command := exec.CommandContext(ctx, "docker", args...)
+	command.Stdout = &output
+	command.Stderr = &output
+	if err := command.Run(); err != nil {
+		return fmt.Errorf("docker %s: %s\n%s", strings.Join(args, " "), err, output.String())
+	}
+	return nil
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Config    ociDescriptor   `json:"config"`
+	Layers    []ociDescriptor `json:"layers"`
+	Manifests []ociDescriptor `json:"manifests"`
+}
+
+// pushOCILayout pushes the image stored as an OCI image layout tarball to the
+// registry using the Docker Registry HTTP API V2 and returns the pushed digest.
+func pushOCILayout(ctx context.Context, layoutPath, imageURL, iamToken string) (string, error) {
+	ref, err := parseContainerImageURL(imageURL)
+	if err != nil {
+		return "", err
+	}
+
+	layoutDir, err := os.MkdirTemp("", "yandex-oci-layout")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(layoutDir)
+
+	if err := extractTar(layoutPath, layoutDir); err != nil {
+		return "", fmt.Errorf("failed to read OCI layout %q: %s", layoutPath, err)
+	}
+
+	var index ociManifest
+	if err := readJSONFile(filepath.Join(layoutDir, "index.json"), &index); err != nil {
+		return "", fmt.Errorf("failed to read OCI layout %q: %s", layoutPath, err)
+	}
+	if len(index.Manifests) != 1 {
+		return "", fmt.Errorf("OCI layout %q must contain exactly one image, got %d", layoutPath, len(index.Manifests))
+	}
+
+	client := &registryClient{
+		host:       ref.host,
+		repository: ref.repository,
+		password:   iamToken,
+		layoutDir:  layoutDir,
+	}
+	top := index.Manifests[0]
+	if err := client.pushManifest(ctx, top, ref.reference); err != nil {
+		return "", err
+	}
+	return top.Digest, nil
+}
+
+type containerImageRef struct {
+	host       string
+	repository string
+	reference  string
+}
+
+func parseContainerImageURL(imageURL string) (*containerImageRef, error) {
+	host, rest, ok := strings.Cut(imageURL, "/")
+	if !ok || rest == "" {
+		return nil, fmt.Errorf("image url %q must be in form <registry>/<repository>[:<tag>]", imageURL)
+	}
+	if strings.Contains(rest, "@") {
+		return nil, fmt.Errorf("image url %q must reference a tag, not a digest, to push the image", imageURL)
+	}
+
+	ref := &containerImageRef{host: host, repository: rest, reference: "latest"}
+	if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "/") {
+		ref.repository, ref.reference = rest[:i], rest[i+1:]
+	}
+	return ref, nil
+}
+
+type registryClient struct {
+	host       string
+	repository string
+	password   string
+	layoutDir  string
+	bearer     string
+	httpClient *http.Client
+}
+
+func (c *registryClient) client() *http.Client {
+	if c.httpClient != nil {
+		return c.httpClient
+	}
+	return http.DefaultClient
+}
+
+func (c *registryClient) pushManifest(ctx context.Context, desc ociDescriptor, reference string) error {
+	path := c.blobPath(desc.Digest)
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var manifest ociManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return fmt.Errorf("failed to parse manifest %s: %s", desc.Digest, err)
+	}
+
+	switch desc.MediaType {
+	case ociImageIndexMediaType, dockerManifestListMediaType:
+		for _, child := range manifest.Manifests {
+			if err := c.pushManifest(ctx, child, child.Digest); err != nil {
+				return err
+			}
+		}
+	case ociImageManifestMediaType, dockerImageManifestMediaType:
+		for _, blob := range append([]ociDescriptor{manifest.Config}, manifest.Layers...) {
+			if err := c.pushBlob(ctx, blob); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported manifest media type %q", desc.MediaType)
+	}
+
+	resp, err := c.do(ctx, http.MethodPut, c.url("manifests/"+reference), desc.MediaType, func() (io.Reader, error) {
+		return bytes.NewReader(body), nil
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return registryError(resp, "failed to push manifest "+desc.Digest)
+	}
+	return nil
+}
+
+func (c *registryClient) pushBlob(ctx context.Context, desc ociDescriptor) error {
+	resp, err := c.do(ctx, http.MethodHead, c.url("blobs/"+desc.Digest), "", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	resp, err = c.do(ctx, http.MethodPost, c.url("blobs/uploads/"), "", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		return registryError(resp, "failed to start upload of blob "+desc.Digest)
+	}
+
+	location, err := resp.Request.URL.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return fmt.Errorf("invalid upload location for blob %s: %s", desc.Digest, err)
+	}
+	query := location.Query()
+	query.Set("digest", desc.Digest)
+	location.RawQuery = query.Encode()
+
+	resp, err = c.do(ctx, http.MethodPut, location.String(), "application/octet-stream", func() (io.Reader, error) {
+		return os.Open(c.blobPath(desc.Digest))
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return registryError(resp, "failed to upload blob "+desc.Digest)
+	}
+	return nil
+}
+
+// do sends the request and handles the registry authentication challenge:
+// the IAM token is used as a password of the "iam" user, either directly
+// or to obtain a bearer token from the registry token service.
+func (c *registryClient) do(ctx context.Context, method, target, contentType string, body func() (io.Reader, error)) (*http.Response, error) {
+	send := func() (*http.Response, error) {
+		var reader io.Reader
+		if body != nil {
+			r, err := body()
+			if err != nil {
+				return nil, err
+			}
+			if closer, ok := r.(io.Closer); ok {
+				defer closer.Close()
+			}
+			reader = r
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, target, reader)
+		if err != nil {
+			return nil, err
+		}
+		if file, ok := reader.(*os.File); ok {
+			info, err := file.Stat()
+			if err != nil {
+				return nil, err
+			}
+			req.ContentLength = info.Size()
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if c.bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+c.bearer)
+		} else {
+			req.SetBasicAuth("iam", c.password)
+		}
+		return c.client().Do(req)
+	}
+
+	resp, err := send()
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || c.bearer != "" {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return nil, fmt.Errorf("registry %s rejected credentials", c.host)
+	}
+	if c.bearer, err = c.fetchBearerToken(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return send()
+}
+
+func (c *registryClient) fetchBearerToken(ctx context.Context, challenge string) (string, error) {
+	params := parseAuthChallenge(challenge[len("bearer "):])
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid authentication challenge %q", challenge)
+	}
+
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull,push", c.repository))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth("iam", c.password)
+	resp, err := c.client().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", registryError(resp, "failed to get registry token")
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to parse registry token: %s", err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
+
+func parseAuthChallenge(s string) map[string]string {
+	params := make(map[string]string)
+	for _, part := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok {
+			params[strings.ToLower(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return params
+}
+
+func (c *registryClient) url(path string) string {
+	return fmt.Sprintf("https://%s/v2/%s/%s", c.host, c.repository, path)
+}
+
+func (c *registryClient) blobPath(digest string) string {
+	algorithm, hash, _ := strings.Cut(digest, ":")
+	return filepath.Join(c.layoutDir, "blobs", algorithm, hash)
+}
+
+func registryError(resp *http.Response, message string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return fmt.Errorf("%s: %s %s", message, resp.Status, strings.TrimSpace(string(body)))
+}
+
+func readJSONFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func extractTar(src, dest string) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := tar.NewReader(file)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		path := filepath.Join(dest, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(path, filepath.Clean(dest)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file name %q", header.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		out, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(out, reader)
+		out.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
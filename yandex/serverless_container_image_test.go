@@ -0,0 +1,221 @@
+package yandex
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContainerImageURL(t *testing.T) {
+	ref, err := parseContainerImageURL("cr.yandex/crp123/app:v1")
+	require.NoError(t, err)
+	assert.Equal(t, &containerImageRef{host: "cr.yandex", repository: "crp123/app", reference: "v1"}, ref)
+
+	ref, err = parseContainerImageURL("localhost:5000/crp123/app")
+	require.NoError(t, err)
+	assert.Equal(t, &containerImageRef{host: "localhost:5000", repository: "crp123/app", reference: "latest"}, ref)
+
+	_, err = parseContainerImageURL("cr.yandex/crp123/app@sha256:0123")
+	assert.Error(t, err)
+
+	_, err = parseContainerImageURL("app")
+	assert.Error(t, err)
+}
+
+func TestContainerImageSourceHash(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM scratch\n"), 0o644))
+
+	source := &containerImageSource{buildContext: dir, buildArgs: map[string]string{"A": "1"}}
+	first, err := source.hash()
+	require.NoError(t, err)
+
+	second, err := source.hash()
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	source.buildArgs["A"] = "2"
+	withArgs, err := source.hash()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, withArgs)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("print(1)\n"), 0o644))
+	withFile, err := source.hash()
+	require.NoError(t, err)
+	assert.NotEqual(t, withArgs, withFile)
+}
+
+func TestContainerImageSourceHashDockerignore(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "docs"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM scratch\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".dockerignore"), []byte("# comment\n.git\n**/*.md\n!docs/README.md\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("print(1)\n"), 0o644))
+
+	source := &containerImageSource{buildContext: dir}
+	first, err := source.hash()
+	require.NoError(t, err)
+
+	for _, name := range []string{".git/HEAD", "CHANGELOG.md", "docs/guide.md"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o644))
+		ignored, err := source.hash()
+		require.NoError(t, err)
+		assert.Equal(t, first, ignored, name)
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "README.md"), []byte("readme\n"), 0o644))
+	withException, err := source.hash()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, withException)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".dockerignore"), []byte(".git\n"), 0o644))
+	withIgnore, err := source.hash()
+	require.NoError(t, err)
+	assert.NotEqual(t, withException, withIgnore)
+}
+
+// fakeRegistry implements the subset of the Docker Registry HTTP API V2 used to
+// push images, guarded by bearer token authentication.
+type fakeRegistry struct {
+	mu        sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if req.URL.Path == "/token" {
+		if user, password, ok := req.BasicAuth(); !ok || user != "iam" || password != "iam-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"token": "registry-token"})
+		return
+	}
+
+	if req.Header.Get("Authorization") != "Bearer registry-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="https://%s/token",service="registry"`, req.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/crp123/app/")
+	switch {
+	case req.Method == http.MethodHead && strings.HasPrefix(path, "blobs/"):
+		if _, ok := r.blobs[strings.TrimPrefix(path, "blobs/")]; ok {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case req.Method == http.MethodPost && path == "blobs/uploads/":
+		w.Header().Set("Location", "/v2/crp123/app/blobs/uploads/session?state=1")
+		w.WriteHeader(http.StatusAccepted)
+	case req.Method == http.MethodPut && path == "blobs/uploads/session":
+		body, _ := io.ReadAll(req.Body)
+		digest := req.URL.Query().Get("digest")
+		if sha256Digest(body) != digest || req.URL.Query().Get("state") != "1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.blobs[digest] = body
+		w.WriteHeader(http.StatusCreated)
+	case req.Method == http.MethodPut && strings.HasPrefix(path, "manifests/"):
+		body, _ := io.ReadAll(req.Body)
+		var manifest ociManifest
+		json.Unmarshal(body, &manifest)
+		for _, blob := range append([]ociDescriptor{manifest.Config}, manifest.Layers...) {
+			if _, ok := r.blobs[blob.Digest]; !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		r.manifests[strings.TrimPrefix(path, "manifests/")] = body
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func writeTestOCILayout(t *testing.T, path string) string {
+	config := []byte(`{"architecture":"amd64","os":"linux"}`)
+	layer := []byte("layer contents")
+	manifest, _ := json.Marshal(ociManifest{
+		MediaType: ociImageManifestMediaType,
+		Config:    ociDescriptor{MediaType: "application/vnd.oci.image.config.v1+json", Digest: sha256Digest(config), Size: int64(len(config))},
+		Layers:    []ociDescriptor{{MediaType: "application/vnd.oci.image.layer.v1.tar", Digest: sha256Digest(layer), Size: int64(len(layer))}},
+	})
+	index, _ := json.Marshal(ociManifest{
+		Manifests: []ociDescriptor{{MediaType: ociImageManifestMediaType, Digest: sha256Digest(manifest), Size: int64(len(manifest))}},
+	})
+
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	w := tar.NewWriter(file)
+	for name, content := range map[string][]byte{
+		"oci-layout": []byte(`{"imageLayoutVersion":"1.0.0"}`),
+		"index.json": index,
+		"blobs/sha256/" + strings.TrimPrefix(sha256Digest(config), "sha256:"):   config,
+		"blobs/sha256/" + strings.TrimPrefix(sha256Digest(layer), "sha256:"):    layer,
+		"blobs/sha256/" + strings.TrimPrefix(sha256Digest(manifest), "sha256:"): manifest,
+	} {
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return sha256Digest(manifest)
+}
+
+func TestRegistryClientPushOCILayout(t *testing.T) {
+	registry := &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}}
+	server := httptest.NewTLSServer(registry)
+	defer server.Close()
+
+	layoutPath := filepath.Join(t.TempDir(), "image.tar")
+	manifestDigest := writeTestOCILayout(t, layoutPath)
+
+	layoutDir := t.TempDir()
+	require.NoError(t, extractTar(layoutPath, layoutDir))
+
+	client := &registryClient{
+		host:       strings.TrimPrefix(server.URL, "https://"),
+		repository: "crp123/app",
+		password:   "iam-token",
+		layoutDir:  layoutDir,
+		httpClient: server.Client(),
+	}
+	require.NoError(t, client.pushManifest(context.Background(), ociDescriptor{MediaType: ociImageManifestMediaType, Digest: manifestDigest}, "v1"))
+
+	assert.Len(t, registry.blobs, 2)
+	require.Contains(t, registry.manifests, "v1")
+	assert.Equal(t, manifestDigest, sha256Digest(registry.manifests["v1"]))
+
+	// Pushing again skips existing blobs and only adds the tag.
+	require.NoError(t, client.pushManifest(context.Background(), ociDescriptor{MediaType: ociImageManifestMediaType, Digest: manifestDigest}, "v2"))
+	assert.Contains(t, registry.manifests, "v2")
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"regexp"
 	"strings"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/credentials"
 )
 
 type Profile = string
@@ -23,13 +25,25 @@ type SharedCredentialsProvider struct {
 type SharedCredentials struct {
 	StorageAccessKey string
 	StorageSecretKey string
+
+	// Settings of the yc CLI profile, if the shared credentials file is yc CLI `config.yaml`.
+	credentials.YCProfile
 }
 
 // Retrieve reads and extracts the credentials for the selected profile from the given file.
+// The file is either a shared credentials file or yc CLI configuration file.
 func (p *SharedCredentialsProvider) Retrieve() (*SharedCredentials, error) {
+	ycProfile, err := credentials.ReadYCProfile(p.Filename, p.Profile)
+	if err != nil {
+		return nil, err
+	}
+	if ycProfile != nil {
+		return &SharedCredentials{YCProfile: *ycProfile}, nil
+	}
+
 	rawCredentialsByProfiles, err := parse(p.Filename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse shared credentials file, error: \"%w\"", err)
+		return nil, fmt.Errorf("failed to parse shared credentials file %q, error: \"%w\"", p.Filename, err)
 	}
 
 	rawCredentials, ok := rawCredentialsByProfiles[p.Profile]
@@ -37,16 +51,16 @@ func (p *SharedCredentialsProvider) Retrieve() (*SharedCredentials, error) {
 		return nil, fmt.Errorf("not found shared credentials for `%v` profile", p.Profile)
 	}
 
-	credentials := SharedCredentials{}
+	sharedCredentials := SharedCredentials{}
 	if val, ok := rawCredentials["storage_access_key"]; ok {
-		credentials.StorageAccessKey = val
+		sharedCredentials.StorageAccessKey = val
 	}
 
 	if val, ok := rawCredentials["storage_secret_key"]; ok {
-		credentials.StorageSecretKey = val
+		sharedCredentials.StorageSecretKey = val
 	}
 
-	return &credentials, nil
+	return &sharedCredentials, nil
 }
 
 func (p *SharedCredentials) HasStorageAccessKeys() bool {
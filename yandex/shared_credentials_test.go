@@ -1,10 +1,14 @@
 package yandex
 
 import (
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/credentials"
 )
 
 func TestRetrieve(t *testing.T) {
@@ -72,6 +76,22 @@ func TestRetrieve(t *testing.T) {
 			fileContent:   "[testing-profile]\nstorage_access_key=access-key\nstorage_secret_key=secret-key",
 			expectedError: "not found shared credentials for `prod-profile` profile",
 		},
+		{
+			name: "yc CLI configuration file",
+			fileContent: "current: dev-profile\nprofiles:\n" +
+				"  dev-profile:\n    token: dev-token\n" +
+				"  prod-profile:\n    token: prod-token\n    cloud-id: prod-cloud-id\n    folder-id: prod-folder-id\n",
+			expectedCredentials: &SharedCredentials{YCProfile: credentials.YCProfile{
+				Token:    "prod-token",
+				CloudID:  "prod-cloud-id",
+				FolderID: "prod-folder-id",
+			}},
+		},
+		{
+			name:          "no given profile in yc CLI configuration file",
+			fileContent:   "current: dev-profile\nprofiles:\n  dev-profile:\n    token: dev-token\n",
+			expectedError: "not found `prod-profile` profile in yc CLI configuration file",
+		},
 		{
 			name:                "trim key/value empty spaces",
 			fileContent:         "[prod-profile]\n storage_access_key  = access-key  \n storage_secret_key  = secret-key  ",
@@ -102,6 +122,16 @@ func TestRetrieve(t *testing.T) {
 	}
 }
 
+func TestRetrieveMissingFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	sharedCredentialsFileProvider := SharedCredentialsProvider{filename, "prod-profile"}
+
+	_, err := sharedCredentialsFileProvider.Retrieve()
+	assert.ErrorContains(t, err, fmt.Sprintf("failed to read shared credentials file %q, "+
+		"expected yc CLI configuration file or shared credentials file in INI format", filename))
+	assert.NotContains(t, err.Error(), "failed to read yc CLI configuration file")
+}
+
 func writeFile(data string) (string, error) {
 	tmpFile, err := os.CreateTemp("", "shared-credentials-file-test")
 
@@ -0,0 +1,13 @@
+def main(event, context):
+    name = 'World'
+    if 'queryStringParameters' in event and 'name' in event['queryStringParameters']:
+        name = event['queryStringParameters']['name']
+
+    return {
+        'statusCode': 200,
+        'headers': {
+            'Content-Type': 'text/plain'
+        },
+        'isBase64Encoded': False,
+        'body': 'Hello, {}!'.format(name)
+    }
\ No newline at end of file
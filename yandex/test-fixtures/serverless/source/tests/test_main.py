@@ -0,0 +1,5 @@
+from main import main
+
+
+def test_main():
+    assert main({}, None)['body'] == 'Hello, World!'
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -36,6 +37,8 @@ import (
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
 )
 
 type instanceAction int
@@ -344,13 +347,14 @@ func mergeSchemas(a, b map[string]*schema.Schema) map[string]*schema.Schema {
 	return merged
 }
 
+// roleMemberToAccessBinding expects member to be validated already.
 func roleMemberToAccessBinding(role, member string) *access.AccessBinding {
-	chunks := strings.SplitN(member, ":", 2)
+	subjectType, id, _ := accessbinding.ParseMember(member)
 	return &access.AccessBinding{
 		RoleId: role,
 		Subject: &access.Subject{
-			Type: chunks[0],
-			Id:   chunks[1],
+			Type: subjectType,
+			Id:   id,
 		},
 	}
 }
@@ -413,6 +417,70 @@ func removeRoleFromBindings(roleForRemove string, bindings []*access.AccessBindi
 	return rb
 }
 
+// accessBindingDeltas returns deltas, which turn the old bindings into the new ones.
+func accessBindingDeltas(oldBindings, newBindings []*access.AccessBinding) []*access.AccessBindingDelta {
+	oldMap := rolesToMembersMap(oldBindings)
+	newMap := rolesToMembersMap(newBindings)
+
+	var deltas []*access.AccessBindingDelta
+	for _, role := range sortedKeys(newMap) {
+		for _, member := range sortedKeys(newMap[role]) {
+			if !oldMap[role][member] {
+				deltas = append(deltas, &access.AccessBindingDelta{
+					Action:        access.AccessBindingAction_ADD,
+					AccessBinding: roleMemberToAccessBinding(role, member),
+				})
+			}
+		}
+	}
+	for _, role := range sortedKeys(oldMap) {
+		for _, member := range sortedKeys(oldMap[role]) {
+			if !newMap[role][member] {
+				deltas = append(deltas, &access.AccessBindingDelta{
+					Action:        access.AccessBindingAction_REMOVE,
+					AccessBinding: roleMemberToAccessBinding(role, member),
+				})
+			}
+		}
+	}
+	return deltas
+}
+
+func deltaRoles(deltas []*access.AccessBindingDelta) []string {
+	roles := make(map[string]bool)
+	for _, d := range deltas {
+		roles[d.AccessBinding.RoleId] = true
+	}
+	return sortedKeys(roles)
+}
+
+func bindingRoles(bindings []*access.AccessBinding) []string {
+	return sortedKeys(rolesToMembersMap(bindings))
+}
+
+// changedRoles returns the roles, whose members differ in the expected and the actual bindings.
+func changedRoles(expected, actual []*access.AccessBinding, roles []string) []string {
+	expectedMap := rolesToMembersMap(expected)
+	actualMap := rolesToMembersMap(actual)
+
+	var changed []string
+	for _, role := range roles {
+		if !reflect.DeepEqual(sortedKeys(expectedMap[role]), sortedKeys(actualMap[role])) {
+			changed = append(changed, role)
+		}
+	}
+	return changed
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func countBatches(size, batchSize int) int {
 	iterations := size / batchSize
 	if size%batchSize > 0 {